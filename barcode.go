@@ -0,0 +1,316 @@
+package goisbn
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// BarcodeFormat is the image format of a barcode rendered by Barcode
+type BarcodeFormat int
+
+const (
+	// BarcodeSVG renders the barcode as a SVG document
+	BarcodeSVG BarcodeFormat = iota
+	// BarcodePNG renders the barcode as a PNG image
+	BarcodePNG
+)
+
+// BarcodeOptions configures the barcode rendered by Barcode
+type BarcodeOptions struct {
+	// Format is the image format, SVG by default
+	Format BarcodeFormat
+	// AddOn is an optional EAN-2 or EAN-5 add-on rendered right of the
+	// EAN-13, eg: 51299 for a suggested retail price of $12.99
+	AddOn string
+	// Scale is the width in pixels of a module, ie: the narrowest bar, of PNG
+	// barcodes. Defaults to 3
+	Scale int
+}
+
+// EAN-13 digits encodings, R being the bitwise complement of L
+var (
+	eanL = [10]string{"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011"}
+	eanG = [10]string{"0100111", "0110011", "0011011", "0100001", "0011101", "0111001", "0000101", "0010001", "0001001", "0010111"}
+	eanR = [10]string{"1110010", "1100110", "1101100", "1000010", "1011100", "1001110", "1010000", "1000100", "1001000", "1110100"}
+)
+
+// ean13Parity is the L/G parity of the left digits, set by the first digit
+var ean13Parity = [10]string{"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG", "LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL"}
+
+// ean5Parity is the L/G parity of the EAN-5 add-on digits, set by its checksum
+var ean5Parity = [10]string{"GGLLL", "GLGLL", "GLLGL", "GLLLG", "LGGLL", "LLGGL", "LLLGG", "LGLGL", "LGLLG", "LLGLG"}
+
+// ean2Parity is the L/G parity of the EAN-2 add-on digits, set by its value
+// modulo 4
+var ean2Parity = [4]string{"LL", "LG", "GL", "GG"}
+
+const (
+	eanGuard       = "101"
+	eanCentreGuard = "01010"
+	addOnGuard     = "01011"
+	addOnSeparator = "01"
+
+	// barcode layout, in modules
+	barcodeQuietLeft   = 11
+	barcodeQuietRight  = 7
+	barcodeAddOnGap    = 9
+	barcodeMargin      = 2
+	barcodeCaptionSize = 4
+	barcodeBarsTop     = barcodeMargin + barcodeCaptionSize + 2
+	barcodeBarsHeight  = 60
+	barcodeGuardExtra  = 5
+	barcodeDigitSize   = 7
+	barcodeDigitsTop   = barcodeBarsTop + barcodeBarsHeight + 1
+	barcodeHeight      = barcodeDigitsTop + barcodeDigitSize + barcodeMargin
+)
+
+// barcodeBar is a bar of a barcode, in modules
+type barcodeBar struct {
+	x, y, width, height int
+}
+
+// barcodeText is a line of human readable text of a barcode, in modules. x is
+// the horizontal centre of the text and y its top
+type barcodeText struct {
+	x    float64
+	y    int
+	size int
+	text string
+}
+
+// barcodeLayout is the geometry of a barcode, shared by the SVG and PNG
+// renderers
+type barcodeLayout struct {
+	width  int
+	height int
+	bars   []barcodeBar
+	texts  []barcodeText
+}
+
+// Barcode renders the EAN-13 Bookland barcode of the isbn, with its human
+// readable digits below the bars and the ISBN caption above them. ISBN 10 are
+// rendered as their ISBN 13 form
+func Barcode(isbn string, opts BarcodeOptions) ([]byte, error) {
+	i, err := ParseISBN(isbn)
+	if err != nil {
+		return nil, err
+	}
+	isbn13, _ := i.ToISBN13()
+	if opts.AddOn != "" {
+		if _, err := addOnModules(opts.AddOn); err != nil {
+			return nil, err
+		}
+	}
+	layout := newBarcodeLayout(isbn13, opts.AddOn)
+	switch opts.Format {
+	case BarcodeSVG:
+		return layout.svg(), nil
+	case BarcodePNG:
+		scale := opts.Scale
+		if scale <= 0 {
+			scale = 3
+		}
+		return layout.png(scale)
+	}
+	return nil, errInvalidBarcodeFormat
+}
+
+// ean13Modules returns the 95 modules of the EAN-13, '1' being a bar
+func ean13Modules(ean string) string {
+	var sb strings.Builder
+	sb.WriteString(eanGuard)
+	parity := ean13Parity[ean[0]-'0']
+	for k := 1; k <= 6; k++ {
+		if parity[k-1] == 'L' {
+			sb.WriteString(eanL[ean[k]-'0'])
+		} else {
+			sb.WriteString(eanG[ean[k]-'0'])
+		}
+	}
+	sb.WriteString(eanCentreGuard)
+	for k := 7; k <= 12; k++ {
+		sb.WriteString(eanR[ean[k]-'0'])
+	}
+	sb.WriteString(eanGuard)
+	return sb.String()
+}
+
+// addOnModules returns the modules of the EAN-2 or EAN-5 add-on, '1' being a
+// bar
+func addOnModules(addOn string) (string, error) {
+	if len(addOn) != 2 && len(addOn) != 5 {
+		return "", errInvalidAddOn
+	}
+	for k := 0; k < len(addOn); k++ {
+		if !isDigit(addOn[k]) {
+			return "", errInvalidAddOn
+		}
+	}
+	var parity string
+	if len(addOn) == 5 {
+		s := 0
+		for k := 0; k < 5; k++ {
+			w := 3
+			if k%2 == 1 {
+				w = 9
+			}
+			s += int(addOn[k]-'0') * w
+		}
+		parity = ean5Parity[s%10]
+	} else {
+		parity = ean2Parity[(int(addOn[0]-'0')*10+int(addOn[1]-'0'))%4]
+	}
+	var sb strings.Builder
+	sb.WriteString(addOnGuard)
+	for k := 0; k < len(addOn); k++ {
+		if k > 0 {
+			sb.WriteString(addOnSeparator)
+		}
+		if parity[k] == 'L' {
+			sb.WriteString(eanL[addOn[k]-'0'])
+		} else {
+			sb.WriteString(eanG[addOn[k]-'0'])
+		}
+	}
+	return sb.String(), nil
+}
+
+func newBarcodeLayout(isbn13 ISBN, addOn string) *barcodeLayout {
+	ean := isbn13.String()
+	l := &barcodeLayout{height: barcodeHeight}
+	addBars := func(modules string, x int, y int, height func(k int) int) {
+		for k := 0; k < len(modules); k++ {
+			if modules[k] != '1' {
+				continue
+			}
+			// merge adjacent modules into a single bar
+			w := 1
+			for k+w < len(modules) && modules[k+w] == '1' {
+				w++
+			}
+			l.bars = append(l.bars, barcodeBar{x: x + k, y: y, width: w, height: height(k)})
+			k += w - 1
+		}
+	}
+	// guard bars extend below the data bars
+	addBars(ean13Modules(ean), barcodeQuietLeft, barcodeBarsTop, func(k int) int {
+		if k < 3 || (k >= 45 && k < 50) || k >= 92 {
+			return barcodeBarsHeight + barcodeGuardExtra
+		}
+		return barcodeBarsHeight
+	})
+
+	caption, err := isbn13.Hyphenated()
+	if err != nil {
+		caption = ean
+	}
+	l.texts = append(l.texts, barcodeText{x: barcodeQuietLeft + 47.5, y: barcodeMargin, size: barcodeCaptionSize, text: "ISBN " + caption})
+	l.texts = append(l.texts, barcodeText{x: barcodeQuietLeft - 4, y: barcodeDigitsTop, size: barcodeDigitSize, text: ean[:1]})
+	for k := 1; k <= 12; k++ {
+		x := float64(barcodeQuietLeft+3+7*(k-1)) + 3.5
+		if k > 6 {
+			x += 5
+		}
+		l.texts = append(l.texts, barcodeText{x: x, y: barcodeDigitsTop, size: barcodeDigitSize, text: ean[k : k+1]})
+	}
+	l.width = barcodeQuietLeft + 95 + barcodeQuietRight
+
+	if addOn != "" {
+		modules, _ := addOnModules(addOn)
+		x := barcodeQuietLeft + 95 + barcodeAddOnGap
+		// add-on digits are printed above its bars
+		top := barcodeBarsTop + barcodeDigitSize + 2
+		addBars(modules, x, top, func(int) int {
+			return barcodeBarsTop + barcodeBarsHeight + barcodeGuardExtra - top
+		})
+		for k := 0; k < len(addOn); k++ {
+			l.texts = append(l.texts, barcodeText{x: float64(x+5+9*k) + 3.5, y: barcodeBarsTop, size: barcodeDigitSize, text: addOn[k : k+1]})
+		}
+		l.width = x + len(modules) + 5
+	}
+	return l
+}
+
+func (l *barcodeLayout) svg() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%d" height="%d">`, l.width, l.height, l.width*3, l.height*3)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/>`, l.width, l.height)
+	b.WriteString(`<g fill="#000">`)
+	for _, v := range l.bars {
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d"/>`, v.x, v.y, v.width, v.height)
+	}
+	b.WriteString(`</g>`)
+	b.WriteString(`<g font-family="OCR-B, monospace" text-anchor="middle" fill="#000">`)
+	for _, v := range l.texts {
+		// the cap height of a font is about 0.7 of its size
+		fmt.Fprintf(&b, `<text x="%g" y="%d" font-size="%g">%s</text>`, v.x, v.y+v.size, float64(v.size)/0.7, v.text)
+	}
+	b.WriteString(`</g></svg>`)
+	return b.Bytes()
+}
+
+func (l *barcodeLayout) png(scale int) ([]byte, error) {
+	img := image.NewGray(image.Rect(0, 0, l.width*scale, l.height*scale))
+	for k := range img.Pix {
+		img.Pix[k] = 0xff
+	}
+	fill := func(x0, y0, x1, y1 int) {
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				img.SetGray(x, y, color.Gray{})
+			}
+		}
+	}
+	for _, v := range l.bars {
+		fill(v.x*scale, v.y*scale, (v.x+v.width)*scale, (v.y+v.height)*scale)
+	}
+	for _, v := range l.texts {
+		// round the size of a glyph pixel to the nearest pixel
+		px := (v.size*scale + len(glyphs['0'])/2) / len(glyphs['0'])
+		if px < 1 {
+			px = 1
+		}
+		advance := (len(glyphs['0'][0]) + 1) * px
+		x := int(v.x*float64(scale)) - (len(v.text)*advance-px)/2
+		y := v.y * scale
+		for _, c := range v.text {
+			for row, bits := range glyphs[c] {
+				for col := 0; col < len(bits); col++ {
+					if bits[col] == '#' {
+						fill(x+col*px, y+row*px, x+(col+1)*px, y+(row+1)*px)
+					}
+				}
+			}
+			x += advance
+		}
+	}
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// glyphs is the 5x7 bitmap font of the human readable text of PNG barcodes
+var glyphs = map[rune][7]string{
+	'0': {".###.", "#...#", "#..##", "#.#.#", "##..#", "#...#", ".###."},
+	'1': {"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'2': {".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	'3': {"#####", "...#.", "..#..", "...#.", "....#", "#...#", ".###."},
+	'4': {"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	'5': {"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	'6': {"..##.", ".#...", "#....", "####.", "#...#", "#...#", ".###."},
+	'7': {"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	'8': {".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+	'9': {".###.", "#...#", "#...#", ".####", "....#", "...#.", ".##.."},
+	'I': {".###.", "..#..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'S': {".####", "#....", "#....", ".###.", "....#", "....#", "####."},
+	'B': {"####.", "#...#", "#...#", "####.", "#...#", "#...#", "####."},
+	'N': {"#...#", "#...#", "##..#", "#.#.#", "#..##", "#...#", "#...#"},
+	'-': {".....", ".....", ".....", "#####", ".....", ".....", "....."},
+	' ': {".....", ".....", ".....", ".....", ".....", ".....", "....."},
+}
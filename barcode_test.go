@@ -0,0 +1,165 @@
+package goisbn
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBarcode(t *testing.T) {
+	type testCase struct {
+		name        string
+		desc        string
+		isbn        string
+		opts        BarcodeOptions
+		expContains []string
+		expWidth    int
+		expHeight   int
+		expErr      error
+	}
+	testCases := []testCase{
+		{
+			name:        "Happy Case",
+			desc:        "svg barcode with caption and digits",
+			isbn:        "978-0-09-958898-6",
+			expContains: []string{`viewBox="0 0 113 78"`, "ISBN 978-0-09-958898-6", ">9<", ">6<"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "isbn 10 rendered as its isbn 13 form",
+			isbn:        "0099588986",
+			expContains: []string{"ISBN 978-0-09-958898-6"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "svg barcode with ean-5 add-on",
+			isbn:        "9780099588986",
+			opts:        BarcodeOptions{AddOn: "51299"},
+			expContains: []string{`viewBox="0 0 168 78"`},
+		},
+		{
+			name:      "Happy Case",
+			desc:      "png barcode with default scale",
+			isbn:      "9780099588986",
+			opts:      BarcodeOptions{Format: BarcodePNG},
+			expWidth:  113 * 3,
+			expHeight: 78 * 3,
+		},
+		{
+			name:      "Happy Case",
+			desc:      "png barcode with ean-2 add-on and scale",
+			isbn:      "9780099588986",
+			opts:      BarcodeOptions{Format: BarcodePNG, AddOn: "05", Scale: 2},
+			expWidth:  (11 + 95 + 9 + 21 + 5) * 2,
+			expHeight: 78 * 2,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid isbn",
+			isbn:   "9780099588987",
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "add-on of invalid length",
+			isbn:   "9780099588986",
+			opts:   BarcodeOptions{AddOn: "512"},
+			expErr: errInvalidAddOn,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "add-on with non digit",
+			isbn:   "9780099588986",
+			opts:   BarcodeOptions{AddOn: "5129A"},
+			expErr: errInvalidAddOn,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "unknown format",
+			isbn:   "9780099588986",
+			opts:   BarcodeOptions{Format: BarcodeFormat(9)},
+			expErr: errInvalidBarcodeFormat,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := Barcode(v.isbn, v.opts)
+		assert.ErrorIs(t, actErr, v.expErr, v.desc)
+		if v.expErr != nil {
+			assert.Nil(t, actRes, v.desc)
+			continue
+		}
+		for _, s := range v.expContains {
+			assert.Contains(t, string(actRes), s, v.desc)
+		}
+		if v.opts.Format == BarcodePNG {
+			img, err := png.Decode(bytes.NewReader(actRes))
+			assert.Nil(t, err, v.desc)
+			assert.Equal(t, v.expWidth, img.Bounds().Dx(), v.desc)
+			assert.Equal(t, v.expHeight, img.Bounds().Dy(), v.desc)
+		}
+	}
+}
+
+func TestEAN13Modules(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		ean    string
+		expRes string
+	}
+	testCases := []testCase{
+		{
+			name: "Happy Case",
+			desc: "bookland ean with first digit 9 parity",
+			ean:  "9780099588986",
+			expRes: "101" + eanL[7] + eanG[8] + eanG[0] + eanL[0] + eanG[9] + eanL[9] +
+				"01010" + eanR[5] + eanR[8] + eanR[8] + eanR[9] + eanR[8] + eanR[6] + "101",
+		},
+	}
+
+	for _, v := range testCases {
+		actRes := ean13Modules(v.ean)
+		assert.Equal(t, 95, len(actRes), v.desc)
+		assert.Equal(t, v.expRes, actRes, v.desc)
+	}
+}
+
+func TestAddOnModules(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		addOn  string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "ean-5 with checksum 5*3+1*9+2*3+9*9+9*3 = 138, parity LGLLG",
+			addOn:  "51299",
+			expRes: "01011" + strings.Join([]string{eanL[5], eanG[1], eanL[2], eanL[9], eanG[9]}, "01"),
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ean-2 with value 05, parity LG",
+			addOn:  "05",
+			expRes: "01011" + eanL[0] + "01" + eanG[5],
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			addOn:  "5129",
+			expErr: errInvalidAddOn,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := addOnModules(v.addOn)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		assert.Equal(t, v.expRes, actRes, v.desc)
+	}
+}
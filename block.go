@@ -0,0 +1,159 @@
+package goisbn
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Block is the block of ISBNs assigned to a registrant, ie: every ISBN 13
+// sharing the same prefix, registration group and registrant elements, eg:
+// 978-0-09
+type Block struct {
+	prefix     string
+	group      string
+	registrant string
+}
+
+// ParseBlock returns the registrant block of the input, eg: 978-0-09 or
+// 978009. The input must end at the boundary of a registrant range allocated
+// by the International ISBN Agency, hyphens if any must match the boundaries
+// of the elements
+func ParseBlock(block string) (Block, error) {
+	digits := stripSeparators(block)
+	for k := 0; k < len(digits); k++ {
+		if !isDigit(digits[k]) {
+			return Block{}, errInvalidBlock
+		}
+	}
+	b, err := ranges.load().block(digits)
+	if err != nil {
+		return Block{}, err
+	}
+	hyphenated := strings.Join(strings.FieldsFunc(block, func(r rune) bool { return r == '-' || r == ' ' }), "-")
+	if strings.ContainsAny(block, "- ") && hyphenated != b.String() {
+		return Block{}, errInvalidBlock
+	}
+	return b, nil
+}
+
+// Block returns the registrant block the ISBN belongs to
+func (i ISBN) Block() (Block, error) {
+	p, err := i.parts()
+	if err != nil {
+		return Block{}, err
+	}
+	return Block{prefix: p.prefix, group: p.group, registrant: p.registrant}, nil
+}
+
+// String returns the hyphenated elements of the block, eg: 978-0-09
+func (b Block) String() string {
+	return b.prefix + "-" + b.group + "-" + b.registrant
+}
+
+// Size returns the number of ISBNs in the block
+func (b Block) Size() int {
+	n := 1
+	for k := 0; k < b.publicationLength(); k++ {
+		n *= 10
+	}
+	return n
+}
+
+// ISBN returns the ISBN 13 of the nth publication of the block, starting at 0
+func (b Block) ISBN(n int) (ISBN, error) {
+	if n < 0 || n >= b.Size() {
+		return ISBN{}, errInvalidBlock
+	}
+	publication := strconv.Itoa(n)
+	stem := b.prefix + b.group + b.registrant + strings.Repeat("0", b.publicationLength()-len(publication)) + publication
+	isbn13 := stem + string(checkDigit13(stem))
+	return ISBN{original: isbn13, canonical: isbn13}, nil
+}
+
+// ISBNs returns every ISBN 13 of the block in publication order. Blocks of
+// the largest publishers, which have the shortest registrant elements, hold up
+// to a million ISBNs, eg: 978-0-00, see Size
+func (b Block) ISBNs() []ISBN {
+	res := make([]ISBN, b.Size())
+	for k := range res {
+		res[k], _ = b.ISBN(k)
+	}
+	return res
+}
+
+// Contains reports whether the ISBN belongs to the block
+func (b Block) Contains(i ISBN) bool {
+	ib, err := i.Block()
+	return err == nil && ib == b
+}
+
+// NextUnused returns the first ISBN 13 of the block, in publication order,
+// that is not in used. ISBNs of used may be in either form, ISBNs from other
+// blocks are ignored
+func (b Block) NextUnused(used []ISBN) (ISBN, error) {
+	taken := make(map[string]bool, len(used))
+	for _, v := range used {
+		isbn13, err := v.ToISBN13()
+		if err != nil {
+			continue
+		}
+		taken[isbn13.canonical] = true
+	}
+	for k := 0; k < b.Size(); k++ {
+		i, _ := b.ISBN(k)
+		if !taken[i.canonical] {
+			return i, nil
+		}
+	}
+	return ISBN{}, errBlockExhausted
+}
+
+func (b Block) publicationLength() int {
+	return 12 - len(b.prefix) - len(b.group) - len(b.registrant)
+}
+
+// GroupByRegistrant groups the ISBNs by the registrant block they belong to,
+// keyed by the block, eg: 978-0-09. ISBNs whose registrant cannot be
+// determined from the range data are grouped under the empty key
+func GroupByRegistrant(isbns []ISBN) map[string][]ISBN {
+	res := map[string][]ISBN{}
+	for _, v := range isbns {
+		key := ""
+		if b, err := v.Block(); err == nil {
+			key = b.String()
+		}
+		res[key] = append(res[key], v)
+	}
+	return res
+}
+
+// block returns the registrant block made of the digits, which must end at a
+// registrant boundary
+func (rt *rangeTable) block(digits string) (Block, error) {
+	if len(digits) < 5 || len(digits) > 11 {
+		return Block{}, errInvalidBlock
+	}
+	prefix, ok := rt.prefixes[digits[:3]]
+	if !ok {
+		return Block{}, ErrUnassignedRange
+	}
+	gl := prefix.length((digits[3:] + "0000000")[:7])
+	if gl == 0 {
+		return Block{}, ErrUnassignedRange
+	}
+	if len(digits) <= 3+gl {
+		return Block{}, errInvalidBlock
+	}
+	group, ok := rt.groups[prefix.prefix+"-"+digits[3:3+gl]]
+	if !ok {
+		return Block{}, errGroupNotFound
+	}
+	rl := group.length((digits[3+gl:] + "0000000")[:7])
+	if rl == 0 || 3+gl+rl >= 12 {
+		return Block{}, ErrUnassignedRange
+	}
+	if len(digits) != 3+gl+rl {
+		return Block{}, errInvalidBlock
+	}
+	return Block{prefix: digits[:3], group: digits[3 : 3+gl], registrant: digits[3+gl:]}, nil
+}
@@ -0,0 +1,194 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBlock(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		block     string
+		expString string
+		expSize   int
+		expErr    error
+	}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "hyphenated block",
+			block:     "978-0-09",
+			expString: "978-0-09",
+			expSize:   1000000,
+		},
+		{
+			name:      "Happy Case",
+			desc:      "block without hyphens",
+			block:     "978009",
+			expString: "978-0-09",
+			expSize:   1000000,
+		},
+		{
+			name:      "Happy Case",
+			desc:      "block of a large registrant",
+			block:     "978-0-9990000",
+			expString: "978-0-9990000",
+			expSize:   10,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "digits do not end at a registrant boundary",
+			block:  "9780099",
+			expErr: errInvalidBlock,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "hyphens do not match the element boundaries",
+			block:  "978-009",
+			expErr: errInvalidBlock,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "non digit character",
+			block:  "978-0-0X",
+			expErr: errInvalidBlock,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "unassigned registrant range",
+			block:  "978-87-300",
+			expErr: ErrUnassignedRange,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseBlock(v.block)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		if v.expErr != nil {
+			continue
+		}
+		assert.Equal(t, v.expString, actRes.String(), v.desc)
+		assert.Equal(t, v.expSize, actRes.Size(), v.desc)
+	}
+}
+
+func TestISBNBlock(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13",
+			isbn:   "9780099588986",
+			expRes: "978-0-09",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10",
+			isbn:   "043942089X",
+			expRes: "978-0-439",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "unassigned registrant range",
+			isbn:   "9788730000002",
+			expErr: ErrUnassignedRange,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := mustParseISBN(v.isbn).Block()
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		if v.expErr != nil {
+			continue
+		}
+		assert.Equal(t, v.expRes, actRes.String(), v.desc)
+		assert.True(t, actRes.Contains(mustParseISBN(v.isbn)), v.desc)
+	}
+}
+
+func TestBlockISBNs(t *testing.T) {
+	b, err := ParseBlock("978-0-9990000")
+	assert.Nil(t, err)
+	var actRes []string
+	for _, v := range b.ISBNs() {
+		actRes = append(actRes, v.String())
+	}
+	assert.Equal(t, []string{
+		"9780999000007", "9780999000014", "9780999000021", "9780999000038", "9780999000045",
+		"9780999000052", "9780999000069", "9780999000076", "9780999000083", "9780999000090",
+	}, actRes)
+
+	_, err = b.ISBN(10)
+	assert.Equal(t, errInvalidBlock, err)
+}
+
+func TestNextUnused(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		block  string
+		used   []string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "nothing used yet",
+			block:  "978-0-09",
+			expRes: "9780090000005",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "gap left by used isbns, in either form, and isbns of other blocks",
+			block:  "978-0-09",
+			used:   []string{"9780090000005", "0090000021", "9780099588986", "9781101973394"},
+			expRes: "9780090000012",
+		},
+		{
+			name:  "Sad Case",
+			desc:  "every isbn is used",
+			block: "978-0-9990000",
+			used: []string{
+				"9780999000007", "9780999000014", "9780999000021", "9780999000038", "9780999000045",
+				"9780999000052", "9780999000069", "9780999000076", "9780999000083", "9780999000090",
+			},
+			expErr: errBlockExhausted,
+		},
+	}
+
+	for _, v := range testCases {
+		b, err := ParseBlock(v.block)
+		assert.Nil(t, err, v.desc)
+		var used []ISBN
+		for _, u := range v.used {
+			used = append(used, mustParseISBN(u))
+		}
+		actRes, actErr := b.NextUnused(used)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		assert.Equal(t, v.expRes, actRes.String(), v.desc)
+	}
+}
+
+func TestGroupByRegistrant(t *testing.T) {
+	isbns := []ISBN{
+		mustParseISBN("9780099588986"),
+		mustParseISBN("9781101973394"),
+		mustParseISBN("0099588986"),
+		mustParseISBN("9788730000002"),
+	}
+	actRes := GroupByRegistrant(isbns)
+	assert.Equal(t, map[string][]ISBN{
+		"978-0-09":  {isbns[0], isbns[2]},
+		"978-1-101": {isbns[1]},
+		"":          {isbns[3]},
+	}, actRes)
+}
@@ -0,0 +1,346 @@
+package goisbn
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+const (
+	// decodeAngleStep is the angle in degrees between two scanline directions.
+	// A scanline decodes a barcode up to about 30 degrees off its axis
+	decodeAngleStep = 10
+	// decodeMinContrast is the minimum difference of luminance between the
+	// bars and spaces of a scanline
+	decodeMinContrast = 48
+	// decodeMaxDigitError is the maximum distance, in modules, between the
+	// runs of a digit and its closest encoding
+	decodeMaxDigitError = 1.6
+)
+
+// digitRuns are the widths of the 4 runs of each digit encoding, in modules,
+// indexed by L then G digits. R digits have the widths of L digits
+var digitRuns = func() [20][4]float64 {
+	var res [20][4]float64
+	for k := 0; k < 10; k++ {
+		res[k] = patternRuns(eanL[k])
+		res[10+k] = patternRuns(eanG[k])
+	}
+	return res
+}()
+
+// patternRuns returns the widths of the runs of a 7 modules digit encoding
+func patternRuns(pattern string) [4]float64 {
+	var res [4]float64
+	r := 0
+	for k := 0; k < len(pattern); k++ {
+		if k > 0 && pattern[k] != pattern[k-1] {
+			r++
+		}
+		res[r]++
+	}
+	return res
+}
+
+// DecodeBarcode scans the image for EAN-13 Bookland barcodes and returns the
+// ISBNs they encode, in the order they are found. The image is scanned along
+// lines in every direction so the barcodes may be rotated or moderately
+// blurred.
+//
+// Original returns the scanned digits of each ISBN, including its EAN-2 or
+// EAN-5 add-on if it has one, so they can be passed to ParseEAN to decode the
+// price. EAN-13 that are not ISBN, eg: ISSN or groceries, are ignored
+func DecodeBarcode(img image.Image) ([]ISBN, error) {
+	lum := newLuminance(img)
+	var res []ISBN
+	found := map[string]int{}
+	for _, ean := range lum.scan() {
+		i, err := ParseISBN(ean)
+		if err != nil || !i.IsISBN13() || family(i.String()) != FamilyISBN {
+			continue
+		}
+		k, ok := found[i.String()]
+		if !ok {
+			found[i.String()] = len(res)
+			res = append(res, i)
+			continue
+		}
+		// some scanlines may cross the EAN-13 but miss its add-on
+		if len(ean) > len(res[k].Original()) {
+			res[k] = i
+		}
+	}
+	if len(res) == 0 {
+		return nil, errBarcodeNotFound
+	}
+	return res, nil
+}
+
+// luminance is the grayscale of an image, 0 being black and 255 white
+type luminance struct {
+	width, height int
+	pix           []float64
+}
+
+func newLuminance(img image.Image) *luminance {
+	b := img.Bounds()
+	l := &luminance{width: b.Dx(), height: b.Dy(), pix: make([]float64, b.Dx()*b.Dy())}
+	for y := 0; y < l.height; y++ {
+		for x := 0; x < l.width; x++ {
+			l.pix[y*l.width+x] = float64(color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray).Y)
+		}
+	}
+	return l
+}
+
+// at returns the luminance at x, y interpolated from the 4 nearest pixels
+func (l *luminance) at(x, y float64) float64 {
+	x0, y0 := int(math.Floor(x)), int(math.Floor(y))
+	fx, fy := x-float64(x0), y-float64(y0)
+	x1, y1 := x0+1, y0+1
+	if x1 >= l.width {
+		x1 = x0
+	}
+	if y1 >= l.height {
+		y1 = y0
+	}
+	top := l.pix[y0*l.width+x0]*(1-fx) + l.pix[y0*l.width+x1]*fx
+	bottom := l.pix[y1*l.width+x0]*(1-fx) + l.pix[y1*l.width+x1]*fx
+	return top*(1-fy) + bottom*fy
+}
+
+func (l *luminance) inside(x, y float64) bool {
+	return x >= 0 && y >= 0 && x <= float64(l.width-1) && y <= float64(l.height-1)
+}
+
+// scan samples the image along parallel scanlines for each direction and
+// returns the EAN-13 decoded from them, followed by their add-on if any
+func (l *luminance) scan() []string {
+	var res []string
+	cx, cy := float64(l.width-1)/2, float64(l.height-1)/2
+	half := math.Hypot(float64(l.width), float64(l.height)) / 2
+	spacing := math.Max(2, half/60)
+	for angle := 0; angle < 180; angle += decodeAngleStep {
+		rad := float64(angle) * math.Pi / 180
+		dx, dy := math.Cos(rad), math.Sin(rad)
+		for offset := -half; offset <= half; offset += spacing {
+			ox, oy := cx-dy*offset, cy+dx*offset
+			var samples []float64
+			for t := -half; t <= half; t++ {
+				x, y := ox+dx*t, oy+dy*t
+				if l.inside(x, y) {
+					samples = append(samples, l.at(x, y))
+				}
+			}
+			runs := scanlineRuns(samples)
+			if runs == nil {
+				continue
+			}
+			res = append(res, decodeRuns(runs)...)
+			for k := 0; k < len(runs)/2; k++ {
+				runs[k], runs[len(runs)-1-k] = runs[len(runs)-1-k], runs[k]
+			}
+			res = append(res, decodeRuns(runs)...)
+		}
+	}
+	return res
+}
+
+// run is a bar or a space of a scanline, its width measured in samples
+type run struct {
+	bar   bool
+	width float64
+}
+
+// scanlineRuns binarizes the samples at the midpoint between their darkest
+// and lightest luminance and returns the runs between the edges. Edges are
+// interpolated between samples which keeps narrow bars measurable when the
+// image is blurred
+func scanlineRuns(samples []float64) []run {
+	if len(samples) < 95 {
+		return nil
+	}
+	lo, hi := samples[0], samples[0]
+	for _, v := range samples {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	if hi-lo < decodeMinContrast {
+		return nil
+	}
+	threshold := (lo + hi) / 2
+	var runs []run
+	bar := samples[0] < threshold
+	edge := 0.0
+	for k := 1; k < len(samples); k++ {
+		if (samples[k] < threshold) == bar {
+			continue
+		}
+		e := float64(k-1) + (threshold-samples[k-1])/(samples[k]-samples[k-1])
+		runs = append(runs, run{bar: bar, width: e - edge})
+		bar, edge = !bar, e
+	}
+	return append(runs, run{bar: bar, width: float64(len(samples)-1) - edge})
+}
+
+// decodeRuns returns the EAN-13 found in the runs of a scanline
+func decodeRuns(runs []run) []string {
+	var res []string
+	for k := 1; k+59 <= len(runs); k++ {
+		if !runs[k].bar {
+			continue
+		}
+		ean, unit, ok := decodeEAN13(runs, k)
+		if !ok {
+			continue
+		}
+		res = append(res, ean+decodeAddOn(runs, k+59, unit))
+		k += 58
+	}
+	return res
+}
+
+// decodeEAN13 decodes the EAN-13 whose start guard is at runs[start] and
+// returns it along with the width of a module
+func decodeEAN13(runs []run, start int) (string, float64, bool) {
+	total := 0.0
+	for _, v := range runs[start : start+59] {
+		total += v.width
+	}
+	unit := total / 95
+	if runs[start-1].width < 5*unit {
+		return "", 0, false
+	}
+	guards := []int{0, 1, 2, 27, 28, 29, 30, 31, 56, 57, 58}
+	for _, g := range guards {
+		if !isModules(runs[start+g].width, 1, unit) {
+			return "", 0, false
+		}
+	}
+	if start+59 < len(runs) && runs[start+59].width < 5*unit {
+		return "", 0, false
+	}
+
+	digits := make([]byte, 13)
+	parity := make([]byte, 6)
+	for k := 0; k < 6; k++ {
+		d, ok := decodeDigit(runs[start+3+4*k:start+7+4*k], true)
+		if !ok {
+			return "", 0, false
+		}
+		digits[k+1], parity[k] = '0'+byte(d%10), 'L'
+		if d >= 10 {
+			parity[k] = 'G'
+		}
+	}
+	for k, v := range ean13Parity {
+		if v == string(parity) {
+			digits[0] = '0' + byte(k)
+		}
+	}
+	if digits[0] == 0 {
+		return "", 0, false
+	}
+	for k := 0; k < 6; k++ {
+		d, ok := decodeDigit(runs[start+32+4*k:start+36+4*k], false)
+		if !ok {
+			return "", 0, false
+		}
+		digits[k+7] = '0' + byte(d)
+	}
+	if checkDigit13(string(digits[:12])) != digits[12] {
+		return "", 0, false
+	}
+	return string(digits), unit, true
+}
+
+// decodeAddOn decodes the EAN-5 or EAN-2 add-on whose gap from the EAN-13 is
+// at runs[gap]. It returns an empty string if there is none
+func decodeAddOn(runs []run, gap int, unit float64) string {
+	if gap+4 > len(runs) || runs[gap].width < 5*unit || runs[gap].width > 15*unit {
+		return ""
+	}
+	if !isModules(runs[gap+1].width, 1, unit) || !isModules(runs[gap+2].width, 1, unit) || !isModules(runs[gap+3].width, 2, unit) {
+		return ""
+	}
+	for _, n := range []int{5, 2} {
+		addOn, parity, ok := decodeAddOnDigits(runs, gap+4, n, unit)
+		if !ok {
+			continue
+		}
+		if n == 5 {
+			s := 0
+			for k := 0; k < 5; k++ {
+				w := 3
+				if k%2 == 1 {
+					w = 9
+				}
+				s += int(addOn[k]-'0') * w
+			}
+			ok = ean5Parity[s%10] == parity
+		} else {
+			ok = ean2Parity[(int(addOn[0]-'0')*10+int(addOn[1]-'0'))%4] == parity
+		}
+		if ok {
+			return addOn
+		}
+	}
+	return ""
+}
+
+// decodeAddOnDigits decodes n add-on digits starting at runs[start] and
+// returns them with their parity
+func decodeAddOnDigits(runs []run, start, n int, unit float64) (string, string, bool) {
+	// the quiet zone right of an add-on is only 5 modules, which an image
+	// cropped close to the barcode can cut into
+	end := start + 6*n - 2
+	if end > len(runs) || (end < len(runs) && runs[end].width < 3*unit) {
+		return "", "", false
+	}
+	digits := make([]byte, n)
+	parity := make([]byte, n)
+	for k := 0; k < n; k++ {
+		r := start + 6*k
+		if k < n-1 && (!isModules(runs[r+4].width, 1, unit) || !isModules(runs[r+5].width, 1, unit)) {
+			return "", "", false
+		}
+		d, ok := decodeDigit(runs[r:r+4], true)
+		if !ok {
+			return "", "", false
+		}
+		digits[k], parity[k] = '0'+byte(d%10), 'L'
+		if d >= 10 {
+			parity[k] = 'G'
+		}
+	}
+	return string(digits), string(parity), true
+}
+
+// decodeDigit returns the digit encoded by 4 runs, matched against the L and
+// G encodings if lg, offset by 10 for G, or the R encodings otherwise
+func decodeDigit(runs []run, lg bool) (int, bool) {
+	total := 0.0
+	for _, v := range runs {
+		total += v.width
+	}
+	candidates := 10
+	if lg {
+		candidates = 20
+	}
+	best, bestErr := -1, math.Inf(1)
+	for d := 0; d < candidates; d++ {
+		e := 0.0
+		for k, v := range runs {
+			e += math.Abs(v.width*7/total - digitRuns[d][k])
+		}
+		if e < bestErr {
+			best, bestErr = d, e
+		}
+	}
+	return best, bestErr <= decodeMaxDigitError
+}
+
+// isModules reports whether a run is about n modules wide
+func isModules(width, n, unit float64) bool {
+	return width >= (n-0.5)*unit && width <= (n+0.7)*unit
+}
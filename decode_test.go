@@ -0,0 +1,199 @@
+package goisbn
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBarcode(t *testing.T) {
+	type testCase struct {
+		name        string
+		desc        string
+		img         image.Image
+		expRes      []string
+		expOriginal []string
+		expErr      error
+	}
+	testCases := []testCase{
+		{
+			name:        "Happy Case",
+			desc:        "upright barcode",
+			img:         mustRenderBarcode("9780099588986", ""),
+			expRes:      []string{"9780099588986"},
+			expOriginal: []string{"9780099588986"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "barcode with ean-5 add-on",
+			img:         mustRenderBarcode("9780099588986", "51299"),
+			expRes:      []string{"9780099588986"},
+			expOriginal: []string{"978009958898651299"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "barcode with ean-2 add-on",
+			img:         mustRenderBarcode("9780439420891", "05"),
+			expRes:      []string{"9780439420891"},
+			expOriginal: []string{"978043942089105"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "upside down barcode",
+			img:         rotateImage(mustRenderBarcode("9781101973394", "51299"), 180),
+			expRes:      []string{"9781101973394"},
+			expOriginal: []string{"978110197339451299"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "barcode rotated by 90 degrees",
+			img:         rotateImage(mustRenderBarcode("9783161484100", ""), 90),
+			expRes:      []string{"9783161484100"},
+			expOriginal: []string{"9783161484100"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "barcode rotated by 37 degrees",
+			img:         rotateImage(mustRenderBarcode("9791032305690", "90000"), 37),
+			expRes:      []string{"9791032305690"},
+			expOriginal: []string{"979103230569090000"},
+		},
+		{
+			name:        "Happy Case",
+			desc:        "blurred barcode rotated by 163 degrees",
+			img:         blurImage(rotateImage(mustRenderBarcode("9780099588986", "51299"), 163), 2),
+			expRes:      []string{"9780099588986"},
+			expOriginal: []string{"978009958898651299"},
+		},
+		{
+			name:   "Happy Case",
+			desc:   "two barcodes side by side",
+			img:    sideBySide(mustRenderBarcode("9780099588986", ""), mustRenderBarcode("9780439420891", "")),
+			expRes: []string{"9780099588986", "9780439420891"},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "barcode of a grocery ean",
+			img:    newBarcodeImage(ISBN{canonical: "4006381333931"}, ""),
+			expErr: errBarcodeNotFound,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "blank image",
+			img:    image.NewGray(image.Rect(0, 0, 200, 100)),
+			expErr: errBarcodeNotFound,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := DecodeBarcode(v.img)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		var isbns, originals []string
+		for _, i := range actRes {
+			isbns = append(isbns, i.String())
+			originals = append(originals, i.Original())
+		}
+		assert.Equal(t, v.expRes, isbns, v.desc)
+		if v.expOriginal != nil {
+			assert.Equal(t, v.expOriginal, originals, v.desc)
+		}
+	}
+}
+
+func mustRenderBarcode(isbn, addOn string) image.Image {
+	b, err := Barcode(isbn, BarcodeOptions{Format: BarcodePNG, AddOn: addOn})
+	if err != nil {
+		panic(err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		panic(err)
+	}
+	return img
+}
+
+func newBarcodeImage(isbn13 ISBN, addOn string) image.Image {
+	b, err := newBarcodeLayout(isbn13, addOn).png(3)
+	if err != nil {
+		panic(err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		panic(err)
+	}
+	return img
+}
+
+// rotateImage rotates the image clockwise by deg degrees on a white canvas
+func rotateImage(img image.Image, deg float64) image.Image {
+	b := img.Bounds()
+	rad := deg * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	w := int(math.Abs(float64(b.Dx())*cos) + math.Abs(float64(b.Dy())*sin) + 1)
+	h := int(math.Abs(float64(b.Dx())*sin) + math.Abs(float64(b.Dy())*cos) + 1)
+	res := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			fx, fy := float64(x)-float64(w)/2, float64(y)-float64(h)/2
+			sx := int(math.Round(fx*cos + fy*sin + float64(b.Dx())/2))
+			sy := int(math.Round(-fx*sin + fy*cos + float64(b.Dy())/2))
+			c := color.Gray{Y: 0xff}
+			if image.Pt(sx, sy).In(b) {
+				c = color.GrayModel.Convert(img.At(sx, sy)).(color.Gray)
+			}
+			res.SetGray(x, y, c)
+		}
+	}
+	return res
+}
+
+// blurImage applies a box blur of the radius to the image
+func blurImage(img image.Image, radius int) image.Image {
+	b := img.Bounds()
+	res := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			sum, n := 0, 0
+			for dy := -radius; dy <= radius; dy++ {
+				for dx := -radius; dx <= radius; dx++ {
+					if !image.Pt(x+dx, y+dy).In(b) {
+						continue
+					}
+					sum += int(color.GrayModel.Convert(img.At(x+dx, y+dy)).(color.Gray).Y)
+					n++
+				}
+			}
+			res.SetGray(x, y, color.Gray{Y: uint8(sum / n)})
+		}
+	}
+	return res
+}
+
+// sideBySide places the images next to each other on a white canvas
+func sideBySide(left, right image.Image) image.Image {
+	lb, rb := left.Bounds(), right.Bounds()
+	h := lb.Dy()
+	if rb.Dy() > h {
+		h = rb.Dy()
+	}
+	res := image.NewGray(image.Rect(0, 0, lb.Dx()+rb.Dx(), h))
+	for k := range res.Pix {
+		res.Pix[k] = 0xff
+	}
+	for y := 0; y < lb.Dy(); y++ {
+		for x := 0; x < lb.Dx(); x++ {
+			res.Set(x, y, left.At(lb.Min.X+x, lb.Min.Y+y))
+		}
+	}
+	for y := 0; y < rb.Dy(); y++ {
+		for x := 0; x < rb.Dx(); x++ {
+			res.Set(lb.Dx()+x, y, right.At(rb.Min.X+x, rb.Min.Y+y))
+		}
+	}
+	return res
+}
@@ -0,0 +1,151 @@
+package goisbn
+
+import (
+	"strconv"
+	"strings"
+)
+
+// addOnCurrencies maps the first digit of an EAN-5 price add-on to the ISO
+// 4217 code of the currency it encodes
+var addOnCurrencies = map[byte]string{
+	'0': "GBP",
+	'1': "GBP",
+	'3': "AUD",
+	'4': "NZD",
+	'5': "USD",
+	'6': "CAD",
+}
+
+// addOnNotes describes the EAN-5 add-ons that do not encode a price
+var addOnNotes = map[string]string{
+	"90000": "no suggested retail price",
+	"99990": "used book",
+	"99991": "complimentary copy",
+}
+
+// EAN is a Bookland EAN-13 as emitted by a barcode scanner, along with its
+// optional EAN-2 or EAN-5 add-on
+type EAN struct {
+	// ISBN is the ISBN 13 encoded by the EAN-13
+	ISBN ISBN
+	// AddOn is the 2 or 5 digits supplement, empty if there is none
+	AddOn string
+	// Price is decoded from an EAN-5 add-on, nil if there is none
+	Price *Price
+}
+
+// Price is the suggested retail price encoded by an EAN-5 add-on
+type Price struct {
+	// Currency is the ISO 4217 code of the currency, eg: USD. Empty if the
+	// add-on does not encode a price
+	Currency string
+	// Amount is the price in the minor unit of the currency, eg: 1299 for $12.99
+	Amount int
+	// Note describes an add-on that does not encode a price, eg: no suggested
+	// retail price for 90000
+	Note string
+}
+
+// ParseEAN splits the output of a barcode scanner, eg: 978009958898651299,
+// into the ISBN and the EAN-2 or EAN-5 add-on following it
+func ParseEAN(ean string) (EAN, error) {
+	s := stripSeparators(ean)
+	if len(s) != 13 && len(s) != 15 && len(s) != 18 {
+		return EAN{}, errInvalidEAN
+	}
+	i, err := ParseISBNStrict(s[:13])
+	if err != nil {
+		return EAN{}, err
+	}
+	i.original = ean
+	res := EAN{ISBN: i, AddOn: s[13:]}
+	for k := 0; k < len(res.AddOn); k++ {
+		if !isDigit(res.AddOn[k]) {
+			return EAN{}, errInvalidEAN
+		}
+	}
+	if len(res.AddOn) == 5 {
+		res.Price = decodePrice(res.AddOn)
+	}
+	return res, nil
+}
+
+// decodePrice decodes the suggested retail price of an EAN-5 add-on. Prices in
+// British pound use all 5 digits, eg: 01299 for £12.99, other currencies use
+// the last 4 digits, eg: 51299 for $12.99
+func decodePrice(addOn string) *Price {
+	if note, ok := addOnNotes[addOn]; ok {
+		return &Price{Note: note}
+	}
+	currency, ok := addOnCurrencies[addOn[0]]
+	if !ok {
+		return &Price{Note: "price not encoded"}
+	}
+	digits := addOn[1:]
+	if currency == "GBP" {
+		digits = addOn
+	}
+	amount, _ := strconv.Atoi(digits)
+	return &Price{Currency: currency, Amount: amount}
+}
+
+// Family is the identifier family an EAN-13 belongs to, going by its GS1
+// prefix
+type Family int
+
+const (
+	// FamilyUnknown is returned alongside an error for codes that are not valid
+	FamilyUnknown Family = iota
+	// FamilyISBN is the Bookland family of books, ie: 978 and 979, 979-0
+	// excluded
+	FamilyISBN
+	// FamilyISMN is the family of printed music, ie: 979-0
+	FamilyISMN
+	// FamilyISSN is the family of serials, ie: 977
+	FamilyISSN
+	// FamilyEAN is any other GS1 prefix, eg: groceries
+	FamilyEAN
+)
+
+func (f Family) String() string {
+	switch f {
+	case FamilyISBN:
+		return "ISBN"
+	case FamilyISMN:
+		return "ISMN"
+	case FamilyISSN:
+		return "ISSN"
+	case FamilyEAN:
+		return "EAN"
+	}
+	return "unknown"
+}
+
+// Classify returns the identifier family of a valid EAN-13 or ISBN 10, spaces
+// and hyphens are ignored
+func Classify(code string) (Family, error) {
+	s := stripSeparators(code)
+	if len(s) == 10 {
+		if _, err := ParseISBNStrict(s); err != nil {
+			return FamilyUnknown, err
+		}
+		return FamilyISBN, nil
+	}
+	if len(s) != 13 || !validate13(s) {
+		return FamilyUnknown, errInvalidEAN
+	}
+	return family(s), nil
+}
+
+// family returns the identifier family of an EAN-13 going by its GS1 prefix
+func family(ean string) Family {
+	switch {
+	case strings.HasPrefix(ean, "9790"):
+		return FamilyISMN
+	case strings.HasPrefix(ean, "978"), strings.HasPrefix(ean, "979"):
+		return FamilyISBN
+	case strings.HasPrefix(ean, "977"):
+		return FamilyISSN
+	}
+	return FamilyEAN
+}
@@ -0,0 +1,166 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEAN(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		ean      string
+		expISBN  string
+		expAddOn string
+		expPrice *Price
+		expErr   error
+	}
+	testCases := []testCase{
+		{
+			name:     "Happy Case",
+			desc:     "ean-5 add-on, price in US dollar",
+			ean:      "978009958898651299",
+			expISBN:  "9780099588986",
+			expAddOn: "51299",
+			expPrice: &Price{Currency: "USD", Amount: 1299},
+		},
+		{
+			name:     "Happy Case",
+			desc:     "ean-5 add-on, price in British pound",
+			ean:      "978009958898601299",
+			expISBN:  "9780099588986",
+			expAddOn: "01299",
+			expPrice: &Price{Currency: "GBP", Amount: 1299},
+		},
+		{
+			name:     "Happy Case",
+			desc:     "ean-5 add-on, price in Canadian dollar",
+			ean:      "978-0-09-958898-6 62450",
+			expISBN:  "9780099588986",
+			expAddOn: "62450",
+			expPrice: &Price{Currency: "CAD", Amount: 2450},
+		},
+		{
+			name:     "Happy Case",
+			desc:     "ean-5 add-on, no suggested retail price",
+			ean:      "9780099588986 90000",
+			expISBN:  "9780099588986",
+			expAddOn: "90000",
+			expPrice: &Price{Note: "no suggested retail price"},
+		},
+		{
+			name:     "Happy Case",
+			desc:     "ean-5 add-on, price not encoded",
+			ean:      "978009958898675000",
+			expISBN:  "9780099588986",
+			expAddOn: "75000",
+			expPrice: &Price{Note: "price not encoded"},
+		},
+		{
+			name:     "Happy Case",
+			desc:     "ean-2 add-on",
+			ean:      "978009958898612",
+			expISBN:  "9780099588986",
+			expAddOn: "12",
+		},
+		{
+			name:    "Happy Case",
+			desc:    "no add-on",
+			ean:     "9780099588986",
+			expISBN: "9780099588986",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			ean:    "97800995889865129",
+			expErr: errInvalidEAN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid add-on",
+			ean:    "97800995889865129X",
+			expErr: errInvalidEAN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid isbn",
+			ean:    "978009958898751299",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseEAN(v.ean)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expISBN, actRes.ISBN.String())
+		assert.Equal(t, v.expAddOn, actRes.AddOn)
+		assert.Equal(t, v.expPrice, actRes.Price)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		code   string
+		expRes Family
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "978 isbn 13",
+			code:   "978-0-09-958898-6",
+			expRes: FamilyISBN,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "979 isbn 13",
+			code:   "9791032305690",
+			expRes: FamilyISBN,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10",
+			code:   "0099588986",
+			expRes: FamilyISBN,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "979-0 ismn",
+			code:   "9790000000001",
+			expRes: FamilyISMN,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "977 issn",
+			code:   "9770317847001",
+			expRes: FamilyISSN,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "grocery ean",
+			code:   "4006381333931",
+			expRes: FamilyEAN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			code:   "4006381333932",
+			expErr: errInvalidEAN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid isbn 10",
+			code:   "0099588987",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := Classify(v.code)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
@@ -95,10 +95,13 @@ type Book struct {
 	Source              string      `json:"source"`
 }
 
-// Identifier contains the ISBN 10 and ISBN 13 data
+// Identifier contains the ISBN 10 and ISBN 13 data, as well as the ISSN of
+// serials and the ISMN of printed music
 type Identifier struct {
 	ISBN   string `json:"isbn"`
 	ISBN13 string `json:"isbn_13"`
+	ISSN   string `json:"issn"`
+	ISMN   string `json:"ismn"`
 }
 
 // ImageLinks contains all the image links related to the book
@@ -1,7 +1,203 @@
 package goisbn
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
-var errBookNotFound = errors.New("book not found")
+// ErrNotFound is reported when a provider does not have the book
+var ErrNotFound = errors.New("book not found")
 
-var errInvalidISBN = errors.New("invalid isbn")
+// ErrInvalidISBN is reported when the ISBN provided is not valid
+var ErrInvalidISBN = errors.New("invalid isbn")
+
+// ErrUnassignedRange is reported when the ISBN is valid but in a range that is
+// not assigned, ie: no book can have it. Get returns it without querying any
+// provider
+var ErrUnassignedRange = errors.New("isbn is in a range that is not assigned")
+
+// ErrUnauthorized is reported when a provider rejects the API key, ie: HTTP
+// 401 or 403
+var ErrUnauthorized = errors.New("provider rejected the api key")
+
+// ErrRateLimited is reported when a provider throttles the requests, ie: HTTP
+// 429
+var ErrRateLimited = errors.New("provider rate limit exceeded")
+
+// ErrProviderUnavailable is reported when a provider cannot be reached or does
+// not answer properly, eg: network errors, timeouts, HTTP 5xx or malformed
+// responses
+var ErrProviderUnavailable = errors.New("provider unavailable")
+
+var errNoISBN10 = errors.New("isbn has no isbn 10 form")
+
+var errGroupNotFound = errors.New("isbn registration group not found in range data")
+
+var errEmptyRangeMessage = errors.New("range message contains no ranges")
+
+var errInvalidStem = errors.New("invalid isbn stem, expected 9 or 12 digits")
+
+var errInvalidISBNA = errors.New("invalid isbn-a")
+
+var errInvalidURN = errors.New("invalid urn:isbn")
+
+var errInvalidAddOn = errors.New("invalid add-on, expected 2 or 5 digits")
+
+var errInvalidBarcodeFormat = errors.New("invalid barcode format")
+
+var errInvalidISSN = errors.New("invalid issn")
+
+var errInvalidISMN = errors.New("invalid ismn")
+
+var errInvalidEAN = errors.New("invalid ean, expected 13 digits with an optional 2 or 5 digits add-on")
+
+var errBarcodeNotFound = errors.New("no isbn barcode found in image")
+
+var errInvalidBlock = errors.New("invalid registrant block")
+
+var errBlockExhausted = errors.New("every isbn of the registrant block is used")
+
+var errInvalidURL = errors.New("invalid url")
+
+var errISBNNotInURL = errors.New("no isbn found in url")
+
+var errInvalidProvider = errors.New("invalid provider, it is nil or its name is empty")
+
+var errProviderRegistered = errors.New("provider with the same name already registered")
+
+var errUnknownProvider = errors.New("unknown provider")
+
+var errNoAPIKey = errors.New("provider does not take an api key")
+
+var errInvalidTimeout = errors.New("invalid timeout, expected a positive duration")
+
+var errInvalidHTTPClient = errors.New("invalid http client")
+
+var errNoBaseURL = errors.New("provider does not take a base url")
+
+var errInvalidBaseURL = errors.New("invalid base url, expected an absolute http or https url")
+
+// ValidationReason describes why an ISBN is not valid
+type ValidationReason int
+
+const (
+	// ReasonLength means the ISBN is neither 10 nor 13 characters long once
+	// spaces and hyphens are removed
+	ReasonLength ValidationReason = iota + 1
+	// ReasonInvalidCharacter means the ISBN contains a character that is neither
+	// a digit nor X
+	ReasonInvalidCharacter
+	// ReasonMisplacedX means the ISBN contains an X anywhere else than as the
+	// check digit of an ISBN 10
+	ReasonMisplacedX
+	// ReasonInvalidPrefix means the ISBN 13 does not start with one of the
+	// Bookland prefixes, ie: 978 or 979, or starts with 979-0 which is reserved
+	// for ISMN
+	ReasonInvalidPrefix
+	// ReasonCheckDigit means the check digit of the ISBN does not match the
+	// one computed from its other digits
+	ReasonCheckDigit
+)
+
+// ValidationError contains the details of why an ISBN is not valid. It matches
+// ErrInvalidISBN with errors.Is
+type ValidationError struct {
+	// ISBN is the input as provided
+	ISBN string
+	// Reason is why the ISBN is not valid
+	Reason ValidationReason
+	// Length is the length of the ISBN once spaces and hyphens are removed
+	Length int
+	// Position is the 1 based position of the offending character, spaces and
+	// hyphens excluded. Set for ReasonInvalidCharacter, ReasonMisplacedX and
+	// ReasonCheckDigit
+	Position int
+	// Char is the offending character. Set for ReasonInvalidCharacter,
+	// ReasonMisplacedX and ReasonCheckDigit
+	Char rune
+	// Prefix is the prefix found. Set for ReasonInvalidPrefix
+	Prefix string
+	// Family is the identifier family the code belongs to instead, eg: ISMN or
+	// ISSN. Set for ReasonInvalidPrefix
+	Family Family
+	// Expected is the check digit computed from the other digits. Set for
+	// ReasonCheckDigit
+	Expected rune
+}
+
+func (e *ValidationError) Error() string {
+	switch e.Reason {
+	case ReasonLength:
+		return fmt.Sprintf("invalid isbn %q: length is %d, expected 10 or 13", e.ISBN, e.Length)
+	case ReasonInvalidCharacter:
+		return fmt.Sprintf("invalid isbn %q: invalid character %q at position %d", e.ISBN, e.Char, e.Position)
+	case ReasonMisplacedX:
+		return fmt.Sprintf("invalid isbn %q: X is only allowed as the check digit of an isbn 10, found at position %d", e.ISBN, e.Position)
+	case ReasonInvalidPrefix:
+		return fmt.Sprintf("invalid isbn %q: prefix %s belongs to %s, not ISBN", e.ISBN, e.Prefix, e.Family)
+	case ReasonCheckDigit:
+		return fmt.Sprintf("invalid isbn %q: check digit is %c, expected %c", e.ISBN, e.Char, e.Expected)
+	}
+	return fmt.Sprintf("invalid isbn %q", e.ISBN)
+}
+
+// Is reports whether target is ErrInvalidISBN
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidISBN
+}
+
+// ProviderError is the error of a provider that did not find a book
+type ProviderError struct {
+	// Provider is the name of the provider, eg: google
+	Provider string
+	// Err is the error returned by the provider, matching ErrNotFound,
+	// ErrUnauthorized, ErrRateLimited or ErrProviderUnavailable with errors.Is
+	// for the built-in providers
+	Err error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Provider, e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// LookupError is returned by Get when no provider found the book, with the
+// error of every provider queried
+type LookupError struct {
+	// ISBN is the input as provided
+	ISBN string
+	// Errors contains the error of each provider, in the order the providers
+	// were given
+	Errors []*ProviderError
+}
+
+func (e *LookupError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("book with isbn %q not found: no provider queried", e.ISBN)
+	}
+	errs := make([]string, 0, len(e.Errors))
+	for _, v := range e.Errors {
+		errs = append(errs, v.Error())
+	}
+	return fmt.Sprintf("book with isbn %q not found: %s", e.ISBN, strings.Join(errs, "; "))
+}
+
+// Is reports whether the error of every provider matches target, eg:
+// ErrNotFound when no provider has the book, or ErrProviderUnavailable when
+// every provider is down. The errors of the providers are in Errors when they
+// differ
+func (e *LookupError) Is(target error) bool {
+	if len(e.Errors) == 0 {
+		return target == ErrNotFound
+	}
+	for _, v := range e.Errors {
+		if !errors.Is(v, target) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package goisbn
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Match is an ISBN found in a text by FindISBNs
+type Match struct {
+	// ISBN is the ISBN found, its original form being the text matched
+	ISBN ISBN
+	// Start is the byte offset of the first character of the ISBN in the text
+	Start int
+	// End is the byte offset right after the last character of the ISBN in the
+	// text
+	End int
+	// Qualifier is the binding qualifier found next to the ISBN, ie: pbk, hbk
+	// or ebook. Empty if there is none
+	Qualifier string
+}
+
+var (
+	qualifierAfter  = regexp.MustCompile(`^[\s:]*[(\[]?\s*([A-Za-z][A-Za-z.\-]*)`)
+	qualifierBefore = regexp.MustCompile(`(?:[(\[]\s*([A-Za-z][A-Za-z.\-]*)\s*[)\]]|([A-Za-z][A-Za-z.\-]*))[\s:]*$`)
+	isbnLabel       = regexp.MustCompile(`(?i)isbn(?:-1[03])?[\s:]*$`)
+)
+
+var qualifiers = map[string]string{
+	"pbk":        "pbk",
+	"pb":         "pbk",
+	"paper":      "pbk",
+	"paperback":  "pbk",
+	"softcover":  "pbk",
+	"hbk":        "hbk",
+	"hb":         "hbk",
+	"hc":         "hbk",
+	"cloth":      "hbk",
+	"hardback":   "hbk",
+	"hardcover":  "hbk",
+	"ebook":      "ebook",
+	"e-book":     "ebook",
+	"ebk":        "ebook",
+	"epub":       "ebook",
+	"pdf":        "ebook",
+	"kindle":     "ebook",
+	"electronic": "ebook",
+}
+
+// FindISBNs returns every valid ISBN 10 and ISBN 13 found in the text, in the
+// order they appear. ISBNs may contain hyphens or spaces between their digits
+func FindISBNs(text string) []Match {
+	res := []Match{}
+	prevEnd := 0
+	for start := 0; start < len(text); start++ {
+		if !isDigit(text[start]) || (start > 0 && isAlphanumeric(text[start-1]) && !endsWithISBNLabel(text, start)) {
+			continue
+		}
+		if skipISBNLabel(text, start) {
+			continue
+		}
+		end10, end13 := candidateEnds(text, start)
+		end := 0
+		for _, v := range []int{end13, end10} {
+			if v == 0 || (v < len(text) && isAlphanumeric(text[v])) {
+				continue
+			}
+			if _, err := validate(text[start:v]); err == nil {
+				end = v
+				break
+			}
+		}
+		if end == 0 {
+			continue
+		}
+		i, _ := ParseISBN(text[start:end])
+		qualifier, n := findQualifier(text[prevEnd:start], text[end:])
+		res = append(res, Match{
+			ISBN:      i,
+			Start:     start,
+			End:       end,
+			Qualifier: qualifier,
+		})
+		// a qualifier following the ISBN is not looked for again before the
+		// next one
+		prevEnd = end + n
+		start = end - 1
+	}
+	return res
+}
+
+// candidateEnds returns the offsets right after the 10th and 13th characters
+// of the run of digits starting at start, 0 if the run is shorter. A single
+// hyphen or space is allowed between two digits
+func candidateEnds(text string, start int) (end10, end13 int) {
+	n := 0
+	for k := start; k < len(text); k++ {
+		c := text[k]
+		switch {
+		case isDigit(c):
+			n++
+		case c == 'X' && n == 9:
+			return k + 1, 0
+		case (c == '-' || c == ' ') && k+1 < len(text) && (isDigit(text[k+1]) || text[k+1] == 'X'):
+			continue
+		default:
+			return end10, 0
+		}
+		if n == 10 {
+			end10 = k + 1
+		}
+		if n == 13 {
+			return end10, k + 1
+		}
+	}
+	return end10, 0
+}
+
+// endsWithISBNLabel reports whether the text right before start is an ISBN
+// label, eg: ISBN13. Only the bytes of the longest label, ie: ISBN-13, are
+// matched so that scanning long texts stays linear
+func endsWithISBNLabel(text string, start int) bool {
+	from := start - len("isbn-13")
+	if from < 0 {
+		from = 0
+	}
+	return isbnLabel.MatchString(text[from:start])
+}
+
+// skipISBNLabel reports whether the digits at start are the 10 or 13 of an
+// ISBN-10 or ISBN-13 label
+func skipISBNLabel(text string, start int) bool {
+	if start < 5 || !strings.EqualFold(text[start-5:start-1], "isbn") || (text[start-1] != '-' && text[start-1] != ' ') {
+		return false
+	}
+	label := text[start:]
+	return (strings.HasPrefix(label, "10") || strings.HasPrefix(label, "13")) && (len(label) == 2 || !isDigit(label[2]))
+}
+
+// findQualifier looks for a binding qualifier right after the ISBN, eg:
+// 978-0-09-958898-6 (pbk.), then right before it, eg: (pbk.) ISBN 0099588986.
+// It also returns the number of bytes of after taken by the qualifier, 0 if it
+// is not right after the ISBN
+func findQualifier(before string, after string) (string, int) {
+	if m := qualifierAfter.FindStringSubmatch(after); m != nil {
+		if q, ok := qualifiers[strings.ToLower(strings.TrimSuffix(m[1], "."))]; ok {
+			return q, len(m[0])
+		}
+	}
+	before = isbnLabel.ReplaceAllString(before, "")
+	if m := qualifierBefore.FindStringSubmatch(before); m != nil {
+		q, ok := qualifiers[strings.ToLower(strings.TrimSuffix(m[1]+m[2], "."))]
+		if ok {
+			return q, 0
+		}
+	}
+	return "", 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlphanumeric(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
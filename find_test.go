@@ -0,0 +1,115 @@
+package goisbn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindISBNs(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		text   string
+		expRes []Match
+	}
+	testCases := []testCase{
+		{
+			name: "Happy Case",
+			desc: "hyphenated isbn 13 with label and qualifier after",
+			text: "ISBN-13: 978-0-09-958898-6 (pbk.)",
+			expRes: []Match{
+				{ISBN: mustParseISBN("978-0-09-958898-6"), Start: 9, End: 26, Qualifier: "pbk"},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "isbn 10 with spaces and qualifiers before, long numbers ignored",
+			text: "Hardcover ISBN 0 09 958898 6; ebook: 9781101973394. Order 12345678901234567 too.",
+			expRes: []Match{
+				{ISBN: mustParseISBN("0 09 958898 6"), Start: 15, End: 28, Qualifier: "hbk"},
+				{ISBN: mustParseISBN("9781101973394"), Start: 37, End: 50, Qualifier: "ebook"},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "isbn 10 and 13 labels are not part of the isbn",
+			text: "ISBN-10 0099588986 ISBN-13 9780099588986",
+			expRes: []Match{
+				{ISBN: mustParseISBN("0099588986"), Start: 8, End: 18},
+				{ISBN: mustParseISBN("9780099588986"), Start: 27, End: 40},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "isbn right after the isbn label, isbn within words ignored",
+			text: "(hbk.) ISBN9780099588986, x9780099588986, 97800995889861",
+			expRes: []Match{
+				{ISBN: mustParseISBN("9780099588986"), Start: 11, End: 24, Qualifier: "hbk"},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "isbn 10 with check digit X",
+			text: "isbn 043942089X (paperback)",
+			expRes: []Match{
+				{ISBN: mustParseISBN("043942089X"), Start: 5, End: 15, Qualifier: "pbk"},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "isbn right after the isbn label at the end of a long alphanumeric text",
+			text: strings.Repeat("a1", 20000) + " ISBN9780099588986",
+			expRes: []Match{
+				{ISBN: mustParseISBN("9780099588986"), Start: 40005, End: 40018},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "qualifier following an isbn is not given to the next one",
+			text: "ISBN 9780099588986 (pbk.) ISBN 9780439420891",
+			expRes: []Match{
+				{ISBN: mustParseISBN("9780099588986"), Start: 5, End: 18, Qualifier: "pbk"},
+				{ISBN: mustParseISBN("9780439420891"), Start: 31, End: 44},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "bare qualifier following an isbn is not given to the next one",
+			text: "9780099588986 pbk 9780439420891",
+			expRes: []Match{
+				{ISBN: mustParseISBN("9780099588986"), Start: 0, End: 13, Qualifier: "pbk"},
+				{ISBN: mustParseISBN("9780439420891"), Start: 18, End: 31},
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "qualifiers of consecutive isbns",
+			text: "9780099588986 (pbk.); (hbk.) 9780439420891",
+			expRes: []Match{
+				{ISBN: mustParseISBN("9780099588986"), Start: 0, End: 13, Qualifier: "pbk"},
+				{ISBN: mustParseISBN("9780439420891"), Start: 29, End: 42, Qualifier: "hbk"},
+			},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			text:   "ISBN 978-0-09-958898-7",
+			expRes: []Match{},
+		},
+	}
+
+	for _, v := range testCases {
+		actRes := FindISBNs(v.text)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
+
+func BenchmarkFindISBNs(b *testing.B) {
+	text := strings.Repeat("a1", 40000) + " ISBN 978-0-09-958898-6 (pbk.)"
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		FindISBNs(text)
+	}
+}
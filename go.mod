@@ -1,6 +1,6 @@
 module github.com/abx123/go-isbn
 
-go 1.15
+go 1.16
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
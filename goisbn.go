@@ -1,14 +1,17 @@
 package goisbn
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 )
 
 // DEFAULT_PROVIDERS contains all available providers, ie: Google Books, Open
@@ -26,7 +29,16 @@ type Queryer interface {
 	ValidateISBN(string) bool
 }
 
-type httpClient interface {
+// IdentifierQueryer is the interface for looking up the sibling identifiers of
+// ISBN, ie: ISSN for serials and ISMN for printed music
+type IdentifierQueryer interface {
+	GetISSN(string) (*Book, error)
+	GetISMN(string) (*Book, error)
+}
+
+// HTTPClient sends the HTTP requests of the built-in providers, eg: a
+// *http.Client
+type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
@@ -36,123 +48,318 @@ type GoISBN struct {
 	providers      []string
 	goodreadAPIKey string
 	isbndbAPIKey   string
-	resolvers      map[string]func(string, chan *Book)
-	client         httpClient
+	resolvers      map[string]Provider
+	client         HTTPClient
+	timeout        time.Duration
+	baseURLs       map[string]string
+}
+
+// New generates a new instance of GoISBN configured with the options, eg:
+// WithProviders or WithAPIKey. It queries the default providers unless
+// WithProviders is used. Unlike NewGoISBN, API keys are not read from the
+// environment and unknown providers are reported as an error
+func New(opts ...Option) (*GoISBN, error) {
+	gi := newGoISBN()
+	for _, opt := range opts {
+		if err := opt(gi); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range gi.providers {
+		if _, ok := gi.resolvers[v]; !ok {
+			return nil, fmt.Errorf("%w: %s", errUnknownProvider, v)
+		}
+	}
+	gi.init()
+	return gi, nil
 }
 
-// NewGoISBN generates a new instance of GoISBN
+// NewGoISBN generates a new instance of GoISBN querying the providers named,
+// among the built-in providers and the ones registered with Register. API keys
+// are read from the GOODREAD_APIKEY and ISBNDB_APIKEY environment variables
+// and unknown providers are ignored
 func NewGoISBN(providers []string) *GoISBN {
+	gi := newGoISBN()
+	gi.goodreadAPIKey = os.Getenv(goodreadsAPIKey)
+	gi.isbndbAPIKey = os.Getenv(isbndbAPIKey)
+	gi.providers = providers
+	gi.init()
+	return gi
+}
+
+func newGoISBN() *GoISBN {
 	gi := &GoISBN{
-		goodreadAPIKey: os.Getenv(goodreadsAPIKey),
-		isbndbAPIKey:   os.Getenv(isbndbAPIKey),
-		providers:      providers,
-		client:         &http.Client{Timeout: timeout},
+		timeout: timeout,
+		baseURLs: map[string]string{
+			ProviderGoogle:      googleBooksAPIBase,
+			ProviderOpenLibrary: openLibraryAPIBase,
+			ProviderGoodreads:   goodreadsAPIBase,
+			ProviderIsbndb:      isbndbAPIBase,
+		},
 	}
-	gi.resolvers = map[string]func(string, chan *Book){
-		ProviderGoogle:      (gi.resolveGoogle),
-		ProviderOpenLibrary: (gi.resolveOpenLibrary),
-		ProviderGoodreads:   (gi.resolveGoodreads),
-		ProviderIsbndb:      (gi.resolveISBNDB),
+	gi.resolvers = map[string]Provider{
+		ProviderGoogle:      &builtinProvider{name: ProviderGoogle, lookup: gi.lookupGoogle},
+		ProviderOpenLibrary: &builtinProvider{name: ProviderOpenLibrary, lookup: gi.lookupOpenLibrary},
+		ProviderGoodreads:   &builtinProvider{name: ProviderGoodreads, lookup: gi.lookupGoodreads},
+		ProviderIsbndb:      &builtinProvider{name: ProviderIsbndb, lookup: gi.lookupISBNDB},
+	}
+	for k, v := range registeredProviders() {
+		gi.resolvers[k] = v
 	}
-	gi.providers = gi.resolveProviders()
 	return gi
 }
 
+// init sets up the default HTTP client if none is provided and keeps the
+// providers that can be queried
+func (gi *GoISBN) init() {
+	if gi.client == nil {
+		gi.client = &http.Client{Timeout: gi.timeout}
+	}
+	gi.providers = gi.resolveProviders()
+}
+
 // Get retreives the details of a book with the ISBN provided from previously
 // initialized providers
 func (gi *GoISBN) Get(isbn string) (*Book, error) {
+	return gi.GetContext(context.Background(), isbn)
+}
+
+// GetContext retreives the details of a book with the ISBN provided from
+// previously initialized providers, returning the error of the context if it
+// is done before a book is found. Lookups are bounded by the timeout set with
+// WithTimeout, and the lookups of the other providers are canceled once a book
+// is found. When no provider finds the book, the error is a *LookupError with
+// the error of each provider, the providers that have not answered within the
+// timeout being reported as unavailable
+func (gi *GoISBN) GetContext(ctx context.Context, isbn string) (*Book, error) {
 
-	if !gi.ValidateISBN(isbn) {
-		log.Printf("isbn %s provided is not valid\n", isbn)
-		return nil, errInvalidISBN
+	i, err := ParseISBN(isbn)
+	if err != nil {
+		log.Printf("%s\n", err)
+		return nil, err
+	}
+	// no provider can have a book with an isbn that was never assigned
+	if !i.IsAssigned() {
+		log.Printf("isbn %s provided is in a range that is not assigned\n", isbn)
+		return nil, ErrUnassignedRange
 	}
 
-	book := &Book{}
-	ch := make(chan *Book, len(gi.providers))
-	respCount := 0
+	lookupCtx, cancel := context.WithTimeout(ctx, gi.timeout)
+	defer cancel()
+	ch := make(chan lookupResult, len(gi.providers))
 	for _, v := range gi.providers {
-		go gi.resolvers[v](isbn, ch)
+		go gi.resolve(lookupCtx, v, i, ch)
 	}
 
-	for book.Title == "" {
-		if respCount == len(gi.providers) {
-			log.Printf("book with isbn %s not found from %s\n", isbn, strings.Join(gi.providers, ", "))
-			return nil, errBookNotFound
+	errs := map[string]error{}
+	for respCount := 0; respCount < len(gi.providers) && lookupCtx.Err() == nil; respCount++ {
+		select {
+		case res := <-ch:
+			if res.err == nil {
+				return res.book, nil
+			}
+			errs[res.provider] = res.err
+		case <-lookupCtx.Done():
 		}
-		tempBook := <-ch
-		respCount++
-		if tempBook != nil {
-			book = tempBook
+	}
+	if ctx.Err() != nil {
+		log.Printf("lookup of book with isbn %s stopped: %s\n", isbn, ctx.Err())
+		return nil, ctx.Err()
+	}
+	// if the timeout of the instance fired, the answers received in the
+	// meantime are collected and the providers that have not answered are
+	// reported as unavailable
+	for drained := false; !drained; {
+		select {
+		case res := <-ch:
+			if res.err == nil {
+				return res.book, nil
+			}
+			errs[res.provider] = res.err
+		default:
+			drained = true
 		}
 	}
-	return book, nil
+	lookupErr := &LookupError{ISBN: isbn, Errors: make([]*ProviderError, 0, len(gi.providers))}
+	for _, v := range gi.providers {
+		err, ok := errs[v]
+		if !ok {
+			err = fmt.Errorf("no answer within %s: %w", gi.timeout, ErrProviderUnavailable)
+		}
+		lookupErr.Errors = append(lookupErr.Errors, &ProviderError{Provider: v, Err: err})
+	}
+	log.Printf("%s\n", lookupErr)
+	return nil, lookupErr
 }
 
 // ValidateISBN checks if the input isbn is in a valid ISBN 10 or ISBN 13 format
 func (gi *GoISBN) ValidateISBN(isbn string) bool {
-	isbn = strings.ReplaceAll(strings.ReplaceAll(isbn, " ", ""), "-", "")
-	switch len(isbn) {
-	case 10:
-		return validate10(isbn)
-	case 13:
-		return validate13(isbn)
-	}
-	return false
+	// most isbn only contain digits, spaces and hyphens and are checked without
+	// allocating, the others go through normalization
+	return ValidateString(isbn) || gi.Validate(isbn) == nil
+}
+
+// GetISSN retreives the details of a serial with the ISSN provided. Google
+// Books is the only provider indexing ISSN, its error is returned as a
+// *ProviderError
+func (gi *GoISBN) GetISSN(issn string) (*Book, error) {
+	i, err := ParseISSN(issn)
+	if err != nil {
+		log.Printf("issn %s provided is not valid\n", issn)
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gi.timeout)
+	defer cancel()
+	book, err := gi.searchGoogle(ctx, i.Hyphenated(), i.String())
+	if err != nil {
+		log.Printf("%s\n", err)
+		log.Printf("serial with issn %s not found from %s\n", issn, ProviderGoogle)
+		return nil, &ProviderError{Provider: ProviderGoogle, Err: err}
+	}
+	return book, nil
 }
 
-func (gi *GoISBN) resolveGoogle(isbn string, ch chan *Book) {
-	url := fmt.Sprintf("%s%s%s", googleBooksAPIBase, googleBooksAPIBook, url.Values{"q": {isbn}}.Encode())
+// GetISMN retreives the details of printed music with the ISMN provided.
+// Google Books is the only provider indexing ISMN, its error is returned as a
+// *ProviderError
+func (gi *GoISBN) GetISMN(ismn string) (*Book, error) {
+	i, err := ParseISMN(ismn)
+	if err != nil {
+		log.Printf("ismn %s provided is not valid\n", ismn)
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gi.timeout)
+	defer cancel()
+	book, err := gi.searchGoogle(ctx, i.String(), i.String())
+	if err != nil {
+		log.Printf("%s\n", err)
+		log.Printf("printed music with ismn %s not found from %s\n", ismn, ProviderGoogle)
+		return nil, &ProviderError{Provider: ProviderGoogle, Err: err}
+	}
+	book.IndustryIdentifiers.ISMN = i.String()
+	return book, nil
+}
+
+// Validate checks if the input isbn is in a valid ISBN 10 or ISBN 13 format,
+// returning a *ValidationError describing why it is not otherwise
+func (gi *GoISBN) Validate(isbn string) error {
+	_, err := ParseISBN(isbn)
+	return err
+}
+
+// lookupResult is the outcome of the lookup of a provider, either a book or
+// the error explaining why it was not found
+type lookupResult struct {
+	provider string
+	book     *Book
+	err      error
+}
 
-	req, _ := http.NewRequest(get, url, nil)
+// resolve queries the provider with the ISBN in the form it was provided,
+// falling back to its other form only when the provider reports the book as
+// not found, other errors, eg: ErrRateLimited, are reported without querying
+// the provider again. Forms the provider does not support are skipped
+func (gi *GoISBN) resolve(ctx context.Context, provider string, isbn ISBN, ch chan lookupResult) {
+	p := gi.resolvers[provider]
+	res := lookupResult{provider: provider, err: fmt.Errorf("no isbn form supported: %w", ErrNotFound)}
+	for _, v := range isbn.forms() {
+		if !p.Capabilities().supports(v) {
+			continue
+		}
+		book, err := p.Lookup(ctx, v)
+		if ctx.Err() != nil {
+			// the lookup was canceled, either by the caller, because another
+			// provider found the book or because the timeout fired
+			res.err = fmt.Errorf("lookup stopped: %v: %w", ctx.Err(), ErrProviderUnavailable)
+			break
+		}
+		if err == nil && (book == nil || book.Title == "") {
+			err = fmt.Errorf("no title returned for isbn %s: %w", v, ErrNotFound)
+		}
+		if err == nil {
+			ch <- lookupResult{provider: provider, book: book}
+			return
+		}
+		res.err = err
+		if !errors.Is(err, ErrNotFound) {
+			break
+		}
+	}
+	ch <- res
+}
+
+// statusError returns the error of a non 2xx response from the API, matching
+// ErrUnauthorized, ErrNotFound, ErrRateLimited or ErrProviderUnavailable
+// depending on its status
+func statusError(api string, resp *http.Response) error {
+	var err error
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		err = ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		err = ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		err = ErrRateLimited
+	default:
+		err = ErrProviderUnavailable
+	}
+	return fmt.Errorf("non 200 status from %s: %s: %w", api, resp.Status, err)
+}
+
+func (gi *GoISBN) lookupGoogle(ctx context.Context, isbn ISBN) (*Book, error) {
+	return gi.searchGoogle(ctx, isbn.String(), isbn.String())
+}
+
+// searchGoogle queries Google Books with q and returns the first item found if
+// one of its industry identifiers matches the canonical identifier provided
+func (gi *GoISBN) searchGoogle(ctx context.Context, q string, identifier string) (*Book, error) {
+	url := fmt.Sprintf("%s%s%s", gi.baseURLs[ProviderGoogle], googleBooksAPIBook, url.Values{"q": {q}}.Encode())
+
+	req, _ := http.NewRequestWithContext(ctx, get, url, nil)
 	resp, err := gi.client.Do(req)
 	if err != nil {
-		log.Printf("Error retrieving book details from Google Books API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error retrieving book details from Google Books API: %v: %w", err, ErrProviderUnavailable)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Printf("Google Books API returns non 200 status. Status: %s\n", resp.Status)
-		ch <- nil
-		return
-
+		return nil, statusError("Google Books API", resp)
 	}
 	val := &googleBooksResponse{}
 	err = json.NewDecoder(resp.Body).Decode(&val)
 	if err != nil {
-		log.Printf("Error decoding response from Google Books API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error decoding response from Google Books API: %v: %w", err, ErrProviderUnavailable)
 	}
 
-	if val.TotalItems == 0 {
-		log.Printf("Google Books API returns 0 item\n")
-		ch <- nil
-		return
+	if val.TotalItems == 0 || len(val.Items) == 0 {
+		return nil, fmt.Errorf("no item returned by Google Books API: %w", ErrNotFound)
 	}
 
-	isbn10, isbn13 := "", ""
+	identifiers := &Identifier{}
+	found := false
 	for _, v := range val.Items[0].VolumeInfo.Identifier {
-		if v.Type == "ISBN_10" {
-			isbn10 = v.Identifier
+		switch v.Type {
+		case "ISBN_10":
+			identifiers.ISBN = v.Identifier
+		case "ISBN_13":
+			identifiers.ISBN13 = v.Identifier
+		case "ISSN":
+			identifiers.ISSN = v.Identifier
 		}
-		if v.Type == "ISBN_13" {
-			isbn13 = v.Identifier
+		// identifiers without a type of their own are listed as OTHER, eg:
+		// ISMN:9790260000438
+		id := strings.ToUpper(stripSeparators(v.Identifier))
+		if id == identifier || strings.HasSuffix(id, ":"+identifier) {
+			found = true
 		}
 	}
-	if isbn != isbn10 && isbn != isbn13 {
-		log.Printf("Google Books API returns incorrect item, isbn10: %s, isbn13:%s\n", isbn10, isbn13)
-		ch <- nil
-		return
+	if !found {
+		return nil, fmt.Errorf("incorrect item returned by Google Books API, isbn10: %s, isbn13: %s, issn: %s: %w", identifiers.ISBN, identifiers.ISBN13, identifiers.ISSN, ErrNotFound)
 	}
 	b := val.Items[0].VolumeInfo
-	book := &Book{
-		IndustryIdentifiers: &Identifier{
-			ISBN:   isbn10,
-			ISBN13: isbn13,
-		},
-		Title:   b.Title,
-		Authors: b.Authors,
+	return &Book{
+		IndustryIdentifiers: identifiers,
+		Title:               b.Title,
+		Authors:             b.Authors,
 		ImageLinks: &ImageLinks{
 			SmallImageURL: b.Image.SmallImageURL,
 			ImageURL:      b.Image.ImageURL,
@@ -164,39 +371,29 @@ func (gi *GoISBN) resolveGoogle(isbn string, ch chan *Book) {
 		Publisher:     b.Publisher,
 		Language:      b.Language,
 		Source:        ProviderGoogle,
-	}
-
-	ch <- book
+	}, nil
 }
 
-func (gi *GoISBN) resolveOpenLibrary(isbn string, ch chan *Book) {
-	url := fmt.Sprintf("%s%s%s", openLibraryAPIBase, openLibraryAPIBook, url.Values{"bibkeys": {"ISBN:" + isbn}, "format": {"json"}, "jscmd": {"data"}}.Encode())
+func (gi *GoISBN) lookupOpenLibrary(ctx context.Context, isbn ISBN) (*Book, error) {
+	url := fmt.Sprintf("%s%s%s", gi.baseURLs[ProviderOpenLibrary], openLibraryAPIBook, url.Values{"bibkeys": {"ISBN:" + isbn.String()}, "format": {"json"}, "jscmd": {"data"}}.Encode())
 
-	req, _ := http.NewRequest(get, url, nil)
+	req, _ := http.NewRequestWithContext(ctx, get, url, nil)
 	resp, err := gi.client.Do(req)
 	if err != nil {
-		log.Printf("Error retrieving book details from Open Library API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error retrieving book details from Open Library API: %v: %w", err, ErrProviderUnavailable)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Printf("Open Library API returns non 200 status. Status: %s\n", err)
-		ch <- nil
-		return
+		return nil, statusError("Open Library API", resp)
 	}
 	key := fmt.Sprintf("ISBN:%s", isbn)
 	data := map[string]openLibraryresponse{}
 	err = json.NewDecoder(resp.Body).Decode(&data)
 	if err != nil {
-		log.Printf("Error unmarshaling response from Open Library API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error unmarshaling response from Open Library API: %v: %w", err, ErrProviderUnavailable)
 	}
 	if _, ok := data[key]; !ok {
-		ch <- nil
-		log.Printf("Open Library API returns incorrect item: %s\n", err)
-		return
+		return nil, fmt.Errorf("no item returned by Open Library API: %w", ErrNotFound)
 	}
 	authors := []string{}
 	for _, v := range data[key].Authors {
@@ -217,7 +414,7 @@ func (gi *GoISBN) resolveOpenLibrary(isbn string, ch chan *Book) {
 	for _, v := range data[key].Publishers {
 		publishers = append(publishers, v.Name)
 	}
-	ch <- &Book{
+	return &Book{
 		Title:         data[key].Title,
 		PublishedYear: data[key].PublishedYear,
 		Authors:       authors,
@@ -233,48 +430,40 @@ func (gi *GoISBN) resolveOpenLibrary(isbn string, ch chan *Book) {
 		Publisher: strings.Join(publishers, ", "),
 		// Language: ,
 		Source: ProviderOpenLibrary,
-	}
+	}, nil
 
 }
 
-func (gi *GoISBN) resolveGoodreads(isbn string, ch chan *Book) {
-	url := fmt.Sprintf("%s%s%s", goodreadsAPIBase, goodreadsAPIBook, url.Values{"q": {isbn}, "key": {gi.goodreadAPIKey}}.Encode())
+func (gi *GoISBN) lookupGoodreads(ctx context.Context, isbn ISBN) (*Book, error) {
+	url := fmt.Sprintf("%s%s%s", gi.baseURLs[ProviderGoodreads], goodreadsAPIBook, url.Values{"q": {isbn.String()}, "key": {gi.goodreadAPIKey}}.Encode())
 
-	req, _ := http.NewRequest(get, url, nil)
+	req, _ := http.NewRequestWithContext(ctx, get, url, nil)
 	resp, err := gi.client.Do(req)
 	if err != nil {
-		log.Printf("Error retrieving book details from Goodreads API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error retrieving book details from Goodreads API: %v: %w", err, ErrProviderUnavailable)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Printf("Goodreads API returns non 200 status. Status: %s\n", resp.Status)
-		ch <- nil
-		return
+		return nil, statusError("Goodreads API", resp)
 	}
 	val := &goodreadsResponse{}
 	if err := xml.NewDecoder(resp.Body).Decode(val); err != nil {
-		log.Printf("Error decoding response from Goodreads API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error decoding response from Goodreads API: %v: %w", err, ErrProviderUnavailable)
 	}
 	if val.Search.Results.Work.Book.Title == "" {
-		log.Printf("Goodreads API returns 0 item\n")
-		ch <- nil
-		return
+		return nil, fmt.Errorf("no item returned by Goodreads API: %w", ErrNotFound)
 	}
 	b := val.Search.Results.Work.Book
 
 	identifiers := &Identifier{}
-	if validate10(isbn) {
-		identifiers.ISBN = isbn
+	if isbn.IsISBN10() {
+		identifiers.ISBN = isbn.String()
 	}
-	if validate13(isbn) {
-		identifiers.ISBN13 = isbn
+	if isbn.IsISBN13() {
+		identifiers.ISBN13 = isbn.String()
 	}
 
-	ch <- &Book{
+	return &Book{
 		Title:         b.Title,
 		PublishedYear: fmt.Sprintf("%d", val.Search.Results.Work.PublicationYear),
 		Authors: []string{
@@ -291,40 +480,32 @@ func (gi *GoISBN) resolveGoodreads(isbn string, ch chan *Book) {
 		// Publisher: ,
 		// Language: ,
 		Source: ProviderGoodreads,
-	}
+	}, nil
 }
 
-func (gi *GoISBN) resolveISBNDB(isbn string, ch chan *Book) {
-	url := fmt.Sprintf("%s%s%s", isbndbAPIBase, isbndbAPIBook, isbn)
+func (gi *GoISBN) lookupISBNDB(ctx context.Context, isbn ISBN) (*Book, error) {
+	url := fmt.Sprintf("%s%s%s", gi.baseURLs[ProviderIsbndb], isbndbAPIBook, isbn)
 
-	req, _ := http.NewRequest(get, url, nil)
+	req, _ := http.NewRequestWithContext(ctx, get, url, nil)
 	req.Header.Add(authorizationHeaderKey, gi.isbndbAPIKey)
 	resp, err := gi.client.Do(req)
 	if err != nil {
-		log.Printf("Error retrieving book details from ISBNDB API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error retrieving book details from ISBNDB API: %v: %w", err, ErrProviderUnavailable)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Printf("ISBNDB API returns non 200 status. Status: %s\n", resp.Status)
-		ch <- nil
-		return
+		return nil, statusError("ISBNDB API", resp)
 	}
 	val := &isbndbResponse{}
 	err = json.NewDecoder(resp.Body).Decode(&val)
 	if err != nil {
-		log.Printf("Error decoding response from ISBNDB API: %s\n", err)
-		ch <- nil
-		return
+		return nil, fmt.Errorf("error decoding response from ISBNDB API: %v: %w", err, ErrProviderUnavailable)
 	}
-	if val.Book.ISBN != isbn && val.Book.ISBN13 != isbn {
-		log.Printf("ISBNDB API returns incorrect item, isbn10: %s, isbn13:%s\n", val.Book.ISBN, val.Book.ISBN13)
-		ch <- nil
-		return
+	if val.Book.ISBN != isbn.String() && val.Book.ISBN13 != isbn.String() {
+		return nil, fmt.Errorf("incorrect item returned by ISBNDB API, isbn10: %s, isbn13: %s: %w", val.Book.ISBN, val.Book.ISBN13, ErrNotFound)
 	}
 
-	ch <- &Book{
+	return &Book{
 		Title:         val.Book.Title,
 		PublishedYear: val.Book.PublishedDate,
 		Authors:       val.Book.Authors,
@@ -341,21 +522,23 @@ func (gi *GoISBN) resolveISBNDB(isbn string, ch chan *Book) {
 		Publisher: val.Book.Publisher,
 		Language:  val.Book.Language,
 		Source:    ProviderIsbndb,
-	}
+	}, nil
 }
 
 func (gi *GoISBN) resolveProviders() []string {
-	if len(gi.providers) == 0 {
-		return DEFAULT_PROVIDERS
+	providers := gi.providers
+	if len(providers) == 0 {
+		providers = DEFAULT_PROVIDERS
 	}
-	uniqueProviders := map[string]int{}
+	seen := map[string]bool{}
 	res := []string{}
-	// remove duplicates
-	for _, v := range gi.providers {
-		uniqueProviders[v]++
-	}
-	// check if provider is valid
-	for k := range uniqueProviders {
+	for _, k := range providers {
+		// remove duplicates, keeping the order the providers were given
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		// check if provider is valid
 		if _, ok := gi.resolvers[k]; ok {
 			if k == ProviderGoodreads && gi.goodreadAPIKey == "" {
 				log.Printf("Goodreads API Key not set, removing Goodreads from provider list\n")
@@ -2,11 +2,14 @@ package goisbn
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -122,6 +125,7 @@ func TestValidateISBN(t *testing.T) {
 		actRes := gi.ValidateISBN(v.isbn)
 
 		assert.Equal(t, v.expRes, actRes)
+		assert.Equal(t, v.expRes, gi.Validate(v.isbn) == nil)
 	}
 }
 
@@ -434,7 +438,6 @@ func TestResolveGoogle(t *testing.T) {
 	}
 
 	gi := NewGoISBN([]string{ProviderGoogle})
-	ch := make(chan *Book, 1)
 	for _, v := range testCases {
 		gi.client = &MockClient{
 			MockDo: func(*http.Request) (*http.Response, error) {
@@ -444,8 +447,7 @@ func TestResolveGoogle(t *testing.T) {
 				}, v.err
 			},
 		}
-		gi.resolveGoogle("9781101973394", ch)
-		actRes := <-ch
+		actRes, _ := gi.lookupGoogle(context.Background(), mustParseISBN("9781101973394"))
 		assert.Equal(t, v.expRes, actRes)
 	}
 }
@@ -683,7 +685,6 @@ func TestResolveGoodreads(t *testing.T) {
 	defer unsetEnv()
 	os.Setenv(goodreadsAPIKey, "mock goodread key")
 	gi := NewGoISBN([]string{ProviderGoodreads})
-	ch := make(chan *Book, 1)
 	for _, v := range testCases {
 		gi.client = &MockClient{
 			MockDo: func(*http.Request) (*http.Response, error) {
@@ -693,8 +694,7 @@ func TestResolveGoodreads(t *testing.T) {
 				}, v.err
 			},
 		}
-		gi.resolveGoodreads(v.isbn, ch)
-		actRes := <-ch
+		actRes, _ := gi.lookupGoodreads(context.Background(), mustParseISBN(v.isbn))
 		assert.Equal(t, v.expRes, actRes)
 	}
 }
@@ -1258,7 +1258,6 @@ func TestOpenLibrary(t *testing.T) {
 		},
 	}
 	gi := NewGoISBN([]string{ProviderOpenLibrary})
-	ch := make(chan *Book, 1)
 	for _, v := range testCases {
 		gi.client = &MockClient{
 			MockDo: func(*http.Request) (*http.Response, error) {
@@ -1268,8 +1267,7 @@ func TestOpenLibrary(t *testing.T) {
 				}, v.err
 			},
 		}
-		gi.resolveOpenLibrary(v.isbn, ch)
-		actRes := <-ch
+		actRes, _ := gi.lookupOpenLibrary(context.Background(), mustParseISBN(v.isbn))
 		assert.Equal(t, v.expRes, actRes)
 	}
 }
@@ -1382,7 +1380,6 @@ func TestResolveIsbnDB(t *testing.T) {
 	defer unsetEnv()
 	os.Setenv(isbndbAPIKey, "mock isbndb key")
 	gi := NewGoISBN([]string{ProviderIsbndb})
-	ch := make(chan *Book, 1)
 
 	for _, v := range testCases {
 		gi.client = &MockClient{
@@ -1393,8 +1390,7 @@ func TestResolveIsbnDB(t *testing.T) {
 				}, v.err
 			},
 		}
-		gi.resolveISBNDB(v.isbn, ch)
-		actRes := <-ch
+		actRes, _ := gi.lookupISBNDB(context.Background(), mustParseISBN(v.isbn))
 		assert.Equal(t, v.expRes, actRes)
 
 	}
@@ -1518,22 +1514,74 @@ func TestGet(t *testing.T) {
 			apiRespCode: 200,
 		},
 		{
-			name:   "Sad Case",
-			desc:   "providers return error",
-			isbn:   "9781101973394",
-			expRes: nil,
-			expErr: errBookNotFound,
+			name:        "Sad Case",
+			desc:        "no provider has the book",
+			isbn:        "9781101973394",
+			apiRespCode: 200,
+			apiResp:     `{}`,
+			expRes:      nil,
+			expErr:      ErrNotFound,
+		},
+		{
+			name:        "Sad Case",
+			desc:        "every provider is down",
+			isbn:        "9781101973394",
+			apiRespCode: 503,
+			expRes:      nil,
+			expErr:      ErrProviderUnavailable,
+		},
+		{
+			name:        "Sad Case",
+			desc:        "every provider returns a malformed response",
+			isbn:        "9781101973394",
+			apiRespCode: 200,
+			apiResp:     `<html>`,
+			expRes:      nil,
+			expErr:      ErrProviderUnavailable,
+		},
+		{
+			name:        "Sad Case",
+			desc:        "every provider rejects the api key",
+			isbn:        "9781101973394",
+			apiRespCode: 401,
+			expRes:      nil,
+			expErr:      ErrUnauthorized,
+		},
+		{
+			name:        "Sad Case",
+			desc:        "every provider throttles the requests",
+			isbn:        "9781101973394",
+			apiRespCode: 429,
+			expRes:      nil,
+			expErr:      ErrRateLimited,
 		},
 		{
 			name:   "Sad Case",
 			desc:   "invalid isbn error",
 			isbn:   "9781101973x94",
 			expRes: nil,
-			expErr: errInvalidISBN,
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "ismn is not looked up",
+			isbn:   "9790000000001",
+			expRes: nil,
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn in an unassigned range is not looked up",
+			isbn:   "9788730000002",
+			expRes: nil,
+			expErr: ErrUnassignedRange,
 		},
 	}
-	gi := NewGoISBN(DEFAULT_PROVIDERS)
 	for _, v := range testCases {
+		// a new instance per case, the lookup of the losing provider of the
+		// previous case may still be running
+		v := v
+		gi := NewGoISBN(DEFAULT_PROVIDERS)
 		gi.client = &MockClient{
 			MockDo: func(*http.Request) (*http.Response, error) {
 				return &http.Response{
@@ -1545,8 +1593,451 @@ func TestGet(t *testing.T) {
 		actRes, actErr := gi.Get(v.isbn)
 
 		assert.Equal(t, v.expRes, actRes)
-		assert.Equal(t, v.expErr, actErr)
+		assert.ErrorIs(t, actErr, v.expErr)
 
+		lookupErr := &LookupError{}
+		if errors.As(actErr, &lookupErr) {
+			assert.Equal(t, v.isbn, lookupErr.ISBN, v.desc)
+			assert.Len(t, lookupErr.Errors, len(gi.providers), v.desc)
+		}
+	}
+}
+
+func TestGetProviderErrors(t *testing.T) {
+	defer unsetEnv()()
+	os.Setenv(isbndbAPIKey, "mock isbndb key")
+	gi := NewGoISBN([]string{ProviderGoogle, ProviderIsbndb})
+	gi.client = &MockClient{
+		MockDo: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host == "www.googleapis.com" {
+				return nil, fmt.Errorf("mock timeout")
+			}
+			return &http.Response{
+				StatusCode: 401,
+				Status:     "401 Unauthorized",
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		},
+	}
+
+	actRes, actErr := gi.Get("9780099588986")
+	assert.Nil(t, actRes)
+	for _, v := range []error{ErrNotFound, ErrProviderUnavailable, ErrUnauthorized} {
+		assert.False(t, errors.Is(actErr, v), v.Error())
+	}
+	lookupErr := &LookupError{}
+	if assert.True(t, errors.As(actErr, &lookupErr)) {
+		assert.Equal(t, "9780099588986", lookupErr.ISBN)
+		assert.Equal(t, ProviderGoogle, lookupErr.Errors[0].Provider)
+		assert.ErrorIs(t, lookupErr.Errors[0], ErrProviderUnavailable)
+		assert.Equal(t, ProviderIsbndb, lookupErr.Errors[1].Provider)
+		assert.ErrorIs(t, lookupErr.Errors[1], ErrUnauthorized)
+	}
+	assert.Equal(t, `book with isbn "9780099588986" not found: `+
+		"google: error retrieving book details from Google Books API: mock timeout: provider unavailable; "+
+		"isbndb: non 200 status from ISBNDB API: 401 Unauthorized: provider rejected the api key", actErr.Error())
+}
+
+func TestLookupError(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		errs      []*ProviderError
+		target    error
+		expResult bool
+	}
+	notFound := &ProviderError{Provider: ProviderGoogle, Err: fmt.Errorf("no item returned: %w", ErrNotFound)}
+	down := &ProviderError{Provider: ProviderOpenLibrary, Err: fmt.Errorf("non 200 status: %w", ErrProviderUnavailable)}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "every provider reports not found",
+			errs:      []*ProviderError{notFound, notFound},
+			target:    ErrNotFound,
+			expResult: true,
+		},
+		{
+			name:      "Happy Case",
+			desc:      "every provider is down",
+			errs:      []*ProviderError{down, down},
+			target:    ErrProviderUnavailable,
+			expResult: true,
+		},
+		{
+			name:      "Happy Case",
+			desc:      "no provider queried",
+			target:    ErrNotFound,
+			expResult: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "one provider is down, the book may exist",
+			errs:   []*ProviderError{notFound, down},
+			target: ErrNotFound,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "one provider answered, upstreams are not all down",
+			errs:   []*ProviderError{notFound, down},
+			target: ErrProviderUnavailable,
+		},
+	}
+
+	for _, v := range testCases {
+		err := error(&LookupError{ISBN: "9780099588986", Errors: v.errs})
+		assert.Equal(t, v.expResult, errors.Is(err, v.target), v.desc)
+	}
+}
+
+func TestGetOtherForm(t *testing.T) {
+	type testCase struct {
+		name       string
+		desc       string
+		isbn       string
+		indexed    string
+		respCode   int
+		expRes     *Book
+		expErr     error
+		expQueries []string
+	}
+	apiResp := `{
+		"totalItems": 1,
+		"items": [
+			{
+				"volumeInfo": {
+					"title": "China Rich Girlfriend",
+					"industryIdentifiers": [
+						{
+							"type": "ISBN_10",
+							"identifier": "1101973390"
+						}
+					]
+				}
+			}
+		]
+	}`
+	testCases := []testCase{
+		{
+			name:    "Happy Case",
+			desc:    "provider only indexes the isbn 10 form",
+			isbn:    "9781101973394",
+			indexed: "1101973390",
+			expRes: &Book{
+				Title: "China Rich Girlfriend",
+				IndustryIdentifiers: &Identifier{
+					ISBN: "1101973390",
+				},
+				ImageLinks: &ImageLinks{},
+				Source:     "google",
+			},
+			expQueries: []string{"9781101973394", "1101973390"},
+		},
+		{
+			name:       "Sad Case",
+			desc:       "provider indexes neither form",
+			isbn:       "9781101973394",
+			expErr:     ErrNotFound,
+			expQueries: []string{"9781101973394", "1101973390"},
+		},
+		{
+			name:       "Sad Case",
+			desc:       "rate limited provider is not queried again with the other form",
+			isbn:       "9781101973394",
+			indexed:    "1101973390",
+			respCode:   429,
+			expErr:     ErrRateLimited,
+			expQueries: []string{"9781101973394"},
+		},
+		{
+			name:       "Sad Case",
+			desc:       "provider rejecting the api key is not queried again with the other form",
+			isbn:       "9781101973394",
+			indexed:    "1101973390",
+			respCode:   401,
+			expErr:     ErrUnauthorized,
+			expQueries: []string{"9781101973394"},
+		},
+	}
+	gi := NewGoISBN([]string{ProviderGoogle})
+	for _, v := range testCases {
+		queries := []string{}
+		gi.client = &MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				q := req.URL.Query().Get("q")
+				queries = append(queries, q)
+				if v.respCode != 0 {
+					return &http.Response{
+						StatusCode: v.respCode,
+						Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+					}, nil
+				}
+				if q != v.indexed {
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"totalItems": 0}`))),
+					}, nil
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(apiResp))),
+				}, nil
+			},
+		}
+		actRes, actErr := gi.Get(v.isbn)
+
+		assert.Equal(t, v.expRes, actRes)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expQueries, queries)
+	}
+}
+
+func TestGetIdentifiers(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		issn     string
+		ismn     string
+		apiResp  string
+		expQuery string
+		expRes   *Book
+		expErr   error
+	}
+	testCases := []testCase{
+		{
+			name: "Happy Case",
+			desc: "issn found",
+			issn: "ISSN 0317-8471",
+			apiResp: `{
+				"totalItems": 1,
+				"items": [
+					{
+						"volumeInfo": {
+							"title": "Canadian Journal",
+							"industryIdentifiers": [
+								{
+									"type": "ISSN",
+									"identifier": "0317-8471"
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expQuery: "0317-8471",
+			expRes: &Book{
+				Title: "Canadian Journal",
+				IndustryIdentifiers: &Identifier{
+					ISSN: "0317-8471",
+				},
+				ImageLinks: &ImageLinks{},
+				Source:     "google",
+			},
+		},
+		{
+			name: "Happy Case",
+			desc: "ismn found as other identifier",
+			ismn: "M-2600-0043-8",
+			apiResp: `{
+				"totalItems": 1,
+				"items": [
+					{
+						"volumeInfo": {
+							"title": "Sonatas",
+							"industryIdentifiers": [
+								{
+									"type": "OTHER",
+									"identifier": "ISMN:9790260000438"
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expQuery: "9790260000438",
+			expRes: &Book{
+				Title: "Sonatas",
+				IndustryIdentifiers: &Identifier{
+					ISMN: "9790260000438",
+				},
+				ImageLinks: &ImageLinks{},
+				Source:     "google",
+			},
+		},
+		{
+			name:     "Sad Case",
+			desc:     "issn not found",
+			issn:     "0317-8471",
+			apiResp:  `{"totalItems": 0}`,
+			expQuery: "0317-8471",
+			expErr:   ErrNotFound,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid issn",
+			issn:   "0317-8472",
+			expErr: errInvalidISSN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid ismn",
+			ismn:   "M-2600-0043-9",
+			expErr: errInvalidISMN,
+		},
+	}
+	gi := NewGoISBN([]string{ProviderGoogle})
+	for _, v := range testCases {
+		query := ""
+		gi.client = &MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				query = req.URL.Query().Get("q")
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(v.apiResp))),
+				}, nil
+			},
+		}
+		var actRes *Book
+		var actErr error
+		if v.issn != "" {
+			actRes, actErr = gi.GetISSN(v.issn)
+		} else {
+			actRes, actErr = gi.GetISMN(v.ismn)
+		}
+
+		assert.Equal(t, v.expRes, actRes)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expQuery, query)
+	}
+}
+
+// blockingProvider is a Provider that never finds a book, its lookups only
+// return once their context is done
+type blockingProvider struct {
+	name     string
+	canceled chan error
+}
+
+func (p *blockingProvider) Name() string {
+	return p.name
+}
+
+func (p *blockingProvider) Lookup(ctx context.Context, isbn ISBN) (*Book, error) {
+	<-ctx.Done()
+	p.canceled <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+func (p *blockingProvider) Capabilities() Capabilities {
+	return Capabilities{ISBN10: true, ISBN13: true}
+}
+
+func TestGetContext(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		providers []string
+		timeout   time.Duration
+		giTimeout time.Duration
+		cancel    bool
+		expRes    *Book
+		expErr    error
+	}
+	book := &Book{Title: "Me Before You", Source: "catalog"}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "losing provider is canceled once a book is found",
+			providers: []string{"catalog", "slow"},
+			expRes:    book,
+		},
+		{
+			name:      "Sad Case",
+			desc:      "deadline exceeded before a book is found",
+			providers: []string{"slow"},
+			timeout:   50 * time.Millisecond,
+			expErr:    context.DeadlineExceeded,
+		},
+		{
+			name:      "Sad Case",
+			desc:      "timeout of the instance fires before a book is found",
+			providers: []string{"slow"},
+			giTimeout: 50 * time.Millisecond,
+			expErr:    ErrProviderUnavailable,
+		},
+		{
+			name:      "Sad Case",
+			desc:      "canceled by the caller",
+			providers: []string{"slow"},
+			cancel:    true,
+			expErr:    context.Canceled,
+		},
+	}
+
+	for _, v := range testCases {
+		slow := &blockingProvider{name: "slow", canceled: make(chan error, 2)}
+		assert.Nil(t, Register(slow))
+		assert.Nil(t, Register(&stubProvider{name: "catalog", caps: Capabilities{ISBN13: true}, book: book}))
+		gi := NewGoISBN(v.providers)
+		if v.giTimeout > 0 {
+			gi.timeout = v.giTimeout
+		}
+		unregister("slow")
+		unregister("catalog")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if v.timeout > 0 {
+			ctx, cancel = context.WithTimeout(context.Background(), v.timeout)
+		}
+		if v.cancel {
+			cancel()
+		}
+		actRes, actErr := gi.GetContext(ctx, "9780099588986")
+		cancel()
+		assert.Equal(t, v.expRes, actRes, v.desc)
+		assert.ErrorIs(t, actErr, v.expErr, v.desc)
+
+		select {
+		case err := <-slow.canceled:
+			assert.NotNil(t, err, v.desc)
+		case <-time.After(time.Second):
+			assert.Fail(t, "slow provider not canceled", v.desc)
+		}
+	}
+}
+
+func TestGetContextCancelsRequests(t *testing.T) {
+	defer unsetEnv()()
+	os.Setenv(isbndbAPIKey, "mock isbndb key")
+	gi := NewGoISBN([]string{ProviderGoogle, ProviderIsbndb})
+	canceled := make(chan error, 2)
+	gi.client = &MockClient{
+		MockDo: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host != "www.googleapis.com" {
+				<-req.Context().Done()
+				canceled <- req.Context().Err()
+				return nil, req.Context().Err()
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+					"totalItems": 1,
+					"items": [{"volumeInfo": {
+						"title": "Me Before You",
+						"industryIdentifiers": [{"type": "ISBN_13", "identifier": "9780099588986"}]
+					}}]
+				}`))),
+			}, nil
+		},
+	}
+
+	actRes, actErr := gi.GetContext(context.Background(), "9780099588986")
+	assert.Nil(t, actErr)
+	assert.Equal(t, "Me Before You", actRes.Title)
+	select {
+	case err := <-canceled:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "isbndb request not canceled")
 	}
 }
 
@@ -1566,3 +2057,11 @@ func unsetEnv() (restore func()) {
 		}
 	}
 }
+
+func mustParseISBN(isbn string) ISBN {
+	i, err := ParseISBN(isbn)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
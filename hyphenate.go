@@ -0,0 +1,88 @@
+package goisbn
+
+import "strings"
+
+// Hyphenate returns the isbn hyphenated according to the ranges allocated by
+// the International ISBN Agency, eg: 978-0-09-958898-6
+func Hyphenate(isbn string) (string, error) {
+	i, err := ParseISBN(isbn)
+	if err != nil {
+		return "", err
+	}
+	return i.Hyphenated()
+}
+
+// Hyphenated returns the ISBN hyphenated according to the ranges allocated by
+// the International ISBN Agency
+func (i ISBN) Hyphenated() (string, error) {
+	p, err := i.parts()
+	if err != nil {
+		return "", err
+	}
+	elements := []string{p.prefix, p.group, p.registrant, p.publication, p.checkDigit}
+	if i.IsISBN10() {
+		elements = elements[1:]
+	}
+	return strings.Join(elements, "-"), nil
+}
+
+// Prefix returns the EAN.UCC prefix element of the ISBN, ie: 978 or 979. ISBN
+// 10 have no prefix element
+func (i ISBN) Prefix() (string, error) {
+	p, err := i.parts()
+	if err != nil {
+		return "", err
+	}
+	if i.IsISBN10() {
+		return "", nil
+	}
+	return p.prefix, nil
+}
+
+// RegistrationGroup returns the registration group element of the ISBN
+func (i ISBN) RegistrationGroup() (string, error) {
+	p, err := i.parts()
+	return p.group, err
+}
+
+// Registrant returns the registrant element of the ISBN
+func (i ISBN) Registrant() (string, error) {
+	p, err := i.parts()
+	return p.registrant, err
+}
+
+// Publication returns the publication element of the ISBN
+func (i ISBN) Publication() (string, error) {
+	p, err := i.parts()
+	return p.publication, err
+}
+
+// Group returns the name of the agency of the registration group the ISBN
+// belongs to, eg: English language or Japan. The name is looked up from the
+// range data, bundled or loaded with LoadRangeMessage, no provider is queried
+func (i ISBN) Group() (string, error) {
+	isbn13, err := i.ToISBN13()
+	if err != nil {
+		return "", err
+	}
+	g, err := ranges.load().registrationGroup(isbn13.canonical)
+	if err != nil {
+		return "", err
+	}
+	return g.agency, nil
+}
+
+func (i ISBN) parts() (isbnParts, error) {
+	isbn13, err := i.ToISBN13()
+	if err != nil {
+		return isbnParts{}, err
+	}
+	p, err := ranges.load().split(isbn13.canonical)
+	if err != nil {
+		return isbnParts{}, err
+	}
+	if i.IsISBN10() {
+		p.checkDigit = i.canonical[9:]
+	}
+	return p, nil
+}
@@ -0,0 +1,240 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyphenate(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "english language isbn 13",
+			isbn:   "9780099588986",
+			expRes: "978-0-09-958898-6",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "english language isbn 10",
+			isbn:   "0099588986",
+			expRes: "0-09-958898-6",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 with check digit X",
+			isbn:   "043942089X",
+			expRes: "0-439-42089-X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "3 digits registrant",
+			isbn:   "9781101973394",
+			expRes: "978-1-101-97339-4",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "german language, input already hyphenated differently",
+			isbn:   "97831-6148-4100",
+			expRes: "978-3-16-148410-0",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "979 prefix, 2 digits registration group",
+			isbn:   "9791032305690",
+			expRes: "979-10-323-0569-0",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "5 digits registration group, isbn 10",
+			isbn:   "9992158107",
+			expRes: "99921-58-10-7",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "5 digits registration group, isbn 13",
+			isbn:   "9789993710561",
+			expRes: "978-99937-1-056-1",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "4 digits registrant within a 3 digits registrant range",
+			isbn:   "9780228001072",
+			expRes: "978-0-2280-0107-2",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "7 digits registrant within a 3 digits registrant range",
+			isbn:   "9780648000006",
+			expRes: "978-0-6480000-0-6",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid isbn",
+			isbn:   "9780099588987",
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registrant range not assigned",
+			isbn:   "9788730000002",
+			expErr: ErrUnassignedRange,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "ismn is not an isbn",
+			isbn:   "9790000000001",
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registration group range not assigned",
+			isbn:   "9791300000005",
+			expErr: ErrUnassignedRange,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registration group not found",
+			isbn:   "9786310000008",
+			expErr: errGroupNotFound,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := Hyphenate(v.isbn)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
+
+func TestISBNElements(t *testing.T) {
+	type testCase struct {
+		name           string
+		desc           string
+		isbn           string
+		expPrefix      string
+		expGroup       string
+		expRegistrant  string
+		expPublication string
+	}
+	testCases := []testCase{
+		{
+			name:           "Happy Case",
+			desc:           "isbn 13",
+			isbn:           "9780099588986",
+			expPrefix:      "978",
+			expGroup:       "0",
+			expRegistrant:  "09",
+			expPublication: "958898",
+		},
+		{
+			name:           "Happy Case",
+			desc:           "isbn 10 has no prefix element",
+			isbn:           "0099588986",
+			expGroup:       "0",
+			expRegistrant:  "09",
+			expPublication: "958898",
+		},
+	}
+
+	for _, v := range testCases {
+		i := mustParseISBN(v.isbn)
+		prefix, err := i.Prefix()
+		assert.Nil(t, err)
+		assert.Equal(t, v.expPrefix, prefix)
+		group, err := i.RegistrationGroup()
+		assert.Nil(t, err)
+		assert.Equal(t, v.expGroup, group)
+		registrant, err := i.Registrant()
+		assert.Nil(t, err)
+		assert.Equal(t, v.expRegistrant, registrant)
+		publication, err := i.Publication()
+		assert.Nil(t, err)
+		assert.Equal(t, v.expPublication, publication)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "english language isbn 13",
+			isbn:   "9780099588986",
+			expRes: "English language",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "german language isbn 10",
+			isbn:   "316148410X",
+			expRes: "German language",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "japan",
+			isbn:   "9784000000000",
+			expRes: "Japan",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "4 digits registration group",
+			isbn:   "9789955000006",
+			expRes: "Lithuania",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "5 digits registration group, isbn 10",
+			isbn:   "9992158107",
+			expRes: "Qatar",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "5 digits registration group, isbn 13",
+			isbn:   "9789993710561",
+			expRes: "Macau",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "979 prefix",
+			isbn:   "9791032305690",
+			expRes: "France",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "registrant range not assigned, registration group still known",
+			isbn:   "9788730000002",
+			expRes: "Denmark",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registration group range not assigned",
+			isbn:   "9791300000005",
+			expErr: ErrUnassignedRange,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registration group not found",
+			isbn:   "9786310000008",
+			expErr: errGroupNotFound,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := mustParseISBN(v.isbn).Group()
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
@@ -0,0 +1,117 @@
+package goisbn
+
+import "strings"
+
+// ISBN is a validated ISBN 10 or ISBN 13. It keeps the input it was parsed
+// from alongside the canonical form, ie: without spaces and hyphens
+type ISBN struct {
+	original  string
+	canonical string
+}
+
+// ParseISBN normalizes the input isbn and returns it as an ISBN if it is in a
+// valid ISBN 10 or ISBN 13 format. The error returned is a *ValidationError
+// describing why the isbn is not valid.
+//
+// ParseISBN is lenient with real world input: it accepts unicode dashes and
+// spaces, full-width digits, a lower case x, ISBN:, ISBN-10, ISBN-13 and
+// urn:isbn: prefixes, as well as ISBN 10 prefixed with 978 without
+// recomputing their check digit. Use ParseISBNStrict to reject them
+func ParseISBN(isbn string) (ISBN, error) {
+	return parseISBN(isbn, false)
+}
+
+// ParseISBNStrict returns the input isbn as an ISBN if it is in a valid ISBN
+// 10 or ISBN 13 format, only ignoring ASCII spaces and hyphens
+func ParseISBNStrict(isbn string) (ISBN, error) {
+	return parseISBN(isbn, true)
+}
+
+func parseISBN(isbn string, strict bool) (ISBN, error) {
+	s := isbn
+	if !strict {
+		s = normalize(isbn)
+	}
+	canonical, err := validate(s)
+	if err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			valErr.ISBN = isbn
+		}
+		return ISBN{}, err
+	}
+	return ISBN{original: isbn, canonical: canonical}, nil
+}
+
+// IsISBN10 reports whether the ISBN is in the ISBN 10 form
+func (i ISBN) IsISBN10() bool {
+	return len(i.canonical) == 10
+}
+
+// IsISBN13 reports whether the ISBN is in the ISBN 13 form
+func (i ISBN) IsISBN13() bool {
+	return len(i.canonical) == 13
+}
+
+// String returns the canonical form of the ISBN
+func (i ISBN) String() string {
+	return i.canonical
+}
+
+// Original returns the ISBN exactly as it was provided to ParseISBN
+func (i ISBN) Original() string {
+	return i.original
+}
+
+// Digits returns the numeric value of each digit of the ISBN, with the ISBN 10
+// check digit X represented as 10
+func (i ISBN) Digits() []int {
+	digits := make([]int, len(i.canonical))
+	for k, v := range i.canonical {
+		if v == 'X' {
+			digits[k] = 10
+			continue
+		}
+		digits[k] = int(v - '0')
+	}
+	return digits
+}
+
+// ToISBN13 returns the ISBN 13 form of the ISBN
+func (i ISBN) ToISBN13() (ISBN, error) {
+	switch {
+	case i.IsISBN13():
+		return i, nil
+	case i.IsISBN10():
+		stem := "978" + i.canonical[:9]
+		return ISBN{original: i.original, canonical: stem + string(checkDigit13(stem))}, nil
+	}
+	return ISBN{}, ErrInvalidISBN
+}
+
+// ToISBN10 returns the ISBN 10 form of the ISBN. ISBN 13 with the 979 prefix
+// have no ISBN 10 form
+func (i ISBN) ToISBN10() (ISBN, error) {
+	switch {
+	case i.IsISBN10():
+		return i, nil
+	case i.IsISBN13():
+		if !strings.HasPrefix(i.canonical, "978") {
+			return ISBN{}, errNoISBN10
+		}
+		stem := i.canonical[3:12]
+		return ISBN{original: i.original, canonical: stem + string(checkDigit10(stem))}, nil
+	}
+	return ISBN{}, ErrInvalidISBN
+}
+
+// forms returns the ISBN followed by its other form, if it has one
+func (i ISBN) forms() []ISBN {
+	if i.IsISBN10() {
+		isbn13, _ := i.ToISBN13()
+		return []ISBN{i, isbn13}
+	}
+	if isbn10, err := i.ToISBN10(); err == nil {
+		return []ISBN{i, isbn10}
+	}
+	return []ISBN{i}
+}
@@ -0,0 +1,148 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISBN(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		isbn      string
+		expString string
+		expIs10   bool
+		expIs13   bool
+		expDigits []int
+		expErr    error
+	}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "valid isbn 13 with hyphens",
+			isbn:      "978-0-09-958898-6",
+			expString: "9780099588986",
+			expIs13:   true,
+			expDigits: []int{9, 7, 8, 0, 0, 9, 9, 5, 8, 8, 9, 8, 6},
+		},
+		{
+			name:      "Happy Case",
+			desc:      "valid isbn 10 with spaces",
+			isbn:      "0 09 958898 6",
+			expString: "0099588986",
+			expIs10:   true,
+			expDigits: []int{0, 0, 9, 9, 5, 8, 8, 9, 8, 6},
+		},
+		{
+			name:      "Happy Case",
+			desc:      "valid isbn 10, last digit X",
+			isbn:      "043942089X",
+			expString: "043942089X",
+			expIs10:   true,
+			expDigits: []int{0, 4, 3, 9, 4, 2, 0, 8, 9, 10},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			isbn:   "00995889862",
+			expErr: ErrInvalidISBN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			isbn:   "9780099588987",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseISBN(v.isbn)
+		assert.ErrorIs(t, actErr, v.expErr)
+		if v.expErr != nil {
+			continue
+		}
+		assert.Equal(t, v.isbn, actRes.Original())
+		assert.Equal(t, v.expString, actRes.String())
+		assert.Equal(t, v.expIs10, actRes.IsISBN10())
+		assert.Equal(t, v.expIs13, actRes.IsISBN13())
+		assert.Equal(t, v.expDigits, actRes.Digits())
+	}
+}
+
+func TestToISBN13(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 converted to isbn 13",
+			isbn:   "0099588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 with check digit X converted to isbn 13",
+			isbn:   "043942089X",
+			expRes: "9780439420891",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13 returned as is",
+			isbn:   "9791032305690",
+			expRes: "9791032305690",
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := mustParseISBN(v.isbn).ToISBN13()
+		assert.Nil(t, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+	}
+}
+
+func TestToISBN10(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13 converted to isbn 10",
+			isbn:   "9780099588986",
+			expRes: "0099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13 converted to isbn 10 with check digit X",
+			isbn:   "9780439420891",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 returned as is",
+			isbn:   "0099588986",
+			expRes: "0099588986",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "979 prefixed isbn 13 has no isbn 10 form",
+			isbn:   "9791032305690",
+			expErr: errNoISBN10,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := mustParseISBN(v.isbn).ToISBN10()
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+	}
+}
@@ -0,0 +1,50 @@
+package goisbn
+
+import "strings"
+
+const ismnPrefix = "9790"
+
+// ISMN is a validated International Standard Music Number. It keeps the input
+// it was parsed from alongside the canonical form, ie: the 13 digits starting
+// with 979-0
+type ISMN struct {
+	original  string
+	canonical string
+}
+
+// ParseISMN parses an ISMN in its 13 digits form, eg: 979-0-2600-0043-8, or in
+// its legacy 10 characters form, eg: M-2600-0043-8, optionally prefixed with
+// ISMN
+func ParseISMN(ismn string) (ISMN, error) {
+	s := strings.TrimSpace(ismn)
+	if len(s) >= 4 && strings.EqualFold(s[:4], "ismn") {
+		s = strings.TrimLeft(s[4:], ": ")
+	}
+	s = strings.ToUpper(stripSeparators(s))
+	if len(s) == 10 && s[0] == 'M' {
+		s = ismnPrefix + s[1:]
+	}
+	if len(s) != 13 || family(s) != FamilyISMN || !validate13(s) {
+		return ISMN{}, errInvalidISMN
+	}
+	return ISMN{original: ismn, canonical: s}, nil
+}
+
+// String returns the canonical form of the ISMN, eg: 9790260000438
+func (i ISMN) String() string {
+	return i.canonical
+}
+
+// Original returns the ISMN exactly as it was provided to ParseISMN
+func (i ISMN) Original() string {
+	return i.original
+}
+
+// ToLegacy returns the legacy 10 characters form of the ISMN, eg: M260000438.
+// Both forms share the same check digit
+func (i ISMN) ToLegacy() string {
+	if len(i.canonical) != 13 {
+		return ""
+	}
+	return "M" + i.canonical[len(ismnPrefix):]
+}
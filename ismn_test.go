@@ -0,0 +1,60 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISMN(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		ismn      string
+		expRes    string
+		expLegacy string
+		expErr    error
+	}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "hyphenated 13 digits form",
+			ismn:      "979-0-2600-0043-8",
+			expRes:    "9790260000438",
+			expLegacy: "M260000438",
+		},
+		{
+			name:      "Happy Case",
+			desc:      "legacy form with ismn prefix",
+			ismn:      "ISMN M-2600-0043-8",
+			expRes:    "9790260000438",
+			expLegacy: "M260000438",
+		},
+		{
+			name:      "Happy Case",
+			desc:      "lower case legacy form",
+			ismn:      "m260000438",
+			expRes:    "9790260000438",
+			expLegacy: "M260000438",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			ismn:   "M-2600-0043-9",
+			expErr: errInvalidISMN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn is not an ismn",
+			ismn:   "9791032305690",
+			expErr: errInvalidISMN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseISMN(v.ismn)
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+		assert.Equal(t, v.expLegacy, actRes.ToLegacy())
+	}
+}
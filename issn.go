@@ -0,0 +1,86 @@
+package goisbn
+
+import "strings"
+
+// ISSN is a validated International Standard Serial Number. It keeps the
+// input it was parsed from alongside the canonical form, ie: 8 characters
+// without hyphen
+type ISSN struct {
+	original  string
+	canonical string
+}
+
+// ParseISSN parses an ISSN in its 8 characters form, eg: 0317-8471, optionally
+// prefixed with ISSN, or in its 977 EAN-13 form, eg: 9770317847001
+func ParseISSN(issn string) (ISSN, error) {
+	s := strings.TrimSpace(issn)
+	if len(s) >= 4 && strings.EqualFold(s[:4], "issn") {
+		s = strings.TrimLeft(s[4:], ": ")
+	}
+	s = strings.ToUpper(stripSeparators(s))
+	if len(s) == 13 {
+		if !validate13(s) || family(s) != FamilyISSN {
+			return ISSN{}, errInvalidISSN
+		}
+		stem := s[3:10]
+		return ISSN{original: issn, canonical: stem + string(checkDigitISSN(stem))}, nil
+	}
+	if !validateISSN(s) {
+		return ISSN{}, errInvalidISSN
+	}
+	return ISSN{original: issn, canonical: s}, nil
+}
+
+// String returns the canonical form of the ISSN, eg: 03178471
+func (i ISSN) String() string {
+	return i.canonical
+}
+
+// Original returns the ISSN exactly as it was provided to ParseISSN
+func (i ISSN) Original() string {
+	return i.original
+}
+
+// Hyphenated returns the ISSN in its standard display form, eg: 0317-8471
+func (i ISSN) Hyphenated() string {
+	if len(i.canonical) != 8 {
+		return ""
+	}
+	return i.canonical[:4] + "-" + i.canonical[4:]
+}
+
+// ToEAN13 returns the 977 EAN-13 form of the ISSN, with the issue variant
+// provided, eg: 00 for the regular issue
+func (i ISSN) ToEAN13(variant string) (string, error) {
+	if len(i.canonical) != 8 || len(variant) != 2 || !isDigit(variant[0]) || !isDigit(variant[1]) {
+		return "", errInvalidISSN
+	}
+	stem := "977" + i.canonical[:7] + variant
+	return stem + string(checkDigit13(stem)), nil
+}
+
+func validateISSN(issn string) bool {
+	if len(issn) != 8 {
+		return false
+	}
+	for k := 0; k < 7; k++ {
+		if !isDigit(issn[k]) {
+			return false
+		}
+	}
+	return checkDigitISSN(issn[:7]) == issn[7]
+}
+
+// checkDigitISSN computes the mod 11 check digit of the 7 digits stem of an
+// ISSN, weighted from 8 down to 2
+func checkDigitISSN(stem string) byte {
+	s := 0
+	for k := 0; k < 7; k++ {
+		s += int(stem[k]-'0') * (8 - k)
+	}
+	c := (11 - s%11) % 11
+	if c == 10 {
+		return 'X'
+	}
+	return byte('0' + c)
+}
@@ -0,0 +1,108 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISSN(t *testing.T) {
+	type testCase struct {
+		name          string
+		desc          string
+		issn          string
+		expRes        string
+		expHyphenated string
+		expErr        error
+	}
+	testCases := []testCase{
+		{
+			name:          "Happy Case",
+			desc:          "hyphenated issn",
+			issn:          "0317-8471",
+			expRes:        "03178471",
+			expHyphenated: "0317-8471",
+		},
+		{
+			name:          "Happy Case",
+			desc:          "issn prefix, lower case check digit x",
+			issn:          "ISSN 0000-006x",
+			expRes:        "0000006X",
+			expHyphenated: "0000-006X",
+		},
+		{
+			name:          "Happy Case",
+			desc:          "977 ean-13 form",
+			issn:          "9770317847001",
+			expRes:        "03178471",
+			expHyphenated: "0317-8471",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			issn:   "0317-8472",
+			expErr: errInvalidISSN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid character",
+			issn:   "03A7-8471",
+			expErr: errInvalidISSN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "ean-13 that is not an issn",
+			issn:   "9780099588986",
+			expErr: errInvalidISSN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseISSN(v.issn)
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+		assert.Equal(t, v.expHyphenated, actRes.Hyphenated())
+	}
+}
+
+func TestISSNToEAN13(t *testing.T) {
+	type testCase struct {
+		name    string
+		desc    string
+		issn    string
+		variant string
+		expRes  string
+		expErr  error
+	}
+	testCases := []testCase{
+		{
+			name:    "Happy Case",
+			desc:    "regular issue",
+			issn:    "0317-8471",
+			variant: "00",
+			expRes:  "9770317847001",
+		},
+		{
+			name:    "Happy Case",
+			desc:    "issue variant",
+			issn:    "0317-8471",
+			variant: "01",
+			expRes:  "9770317847018",
+		},
+		{
+			name:    "Sad Case",
+			desc:    "invalid issue variant",
+			issn:    "0317-8471",
+			variant: "1",
+			expErr:  errInvalidISSN,
+		},
+	}
+
+	for _, v := range testCases {
+		i, err := ParseISSN(v.issn)
+		assert.Nil(t, err)
+		actRes, actErr := i.ToEAN13(v.variant)
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
@@ -0,0 +1,51 @@
+package goisbn
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// isbnPrefix matches the labels stripped from the start of an isbn, eg:
+// urn:isbn:, ISBN or ISBN–13: with any dash or space before the 10 or 13. The
+// 10 or 13 is only part of the label when a separator follows it, the isbn
+// itself may start with these digits, eg: ISBN 1391234567
+var isbnPrefix = regexp.MustCompile(`(?i)^(?:urn:isbn:|isbn(?:[\p{Pd}\s]*1[03](?:[\p{Pd}\s:]|$))?)`)
+
+// normalize maps the forms of an isbn found in real world input to the form
+// validate expects, ie: digits and an upper case X only. Unicode dashes and
+// spaces are removed, full-width digits and a lower case x are mapped to their
+// ASCII form, ISBN and urn:isbn: prefixes are stripped and ISBN 10 prefixed
+// with 978 without recomputing their check digit, eg: 978-043942089X or
+// 978-0306406152, are turned back into ISBN 10 when they are not a valid ISBN
+// 13. The EAN-2 or EAN-5 add-on following an ISBN 13 in the output of barcode
+// scanners is dropped
+func normalize(isbn string) string {
+	s := strings.TrimSpace(isbn)
+	if loc := isbnPrefix.FindStringIndex(s); loc != nil {
+		s = strings.TrimLeft(s[loc[1]:], ": ")
+	}
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= '\uFF10' && r <= '\uFF19':
+			return '0' + r - '\uFF10'
+		case r == 'x' || r == '\uFF38' || r == '\uFF58':
+			return 'X'
+		case unicode.Is(unicode.Pd, r) || r == '\u2212' || unicode.IsSpace(r) || r == '\u200B':
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) == 13 && strings.HasPrefix(s, "978") && !validate13(s) && validate10(s[3:]) {
+		s = s[3:]
+	}
+	if (len(s) == 15 || len(s) == 18) && validate13(s[:13]) {
+		s = s[:13]
+	}
+	return s
+}
+
+// stripSeparators removes the spaces and hyphens from the isbn
+func stripSeparators(isbn string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(isbn, " ", ""), "-", "")
+}
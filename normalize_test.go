@@ -0,0 +1,145 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	type testCase struct {
+		name        string
+		desc        string
+		isbn        string
+		expRes      string
+		expStrictOK bool
+	}
+	testCases := []testCase{
+		{
+			name:        "Happy Case",
+			desc:        "ascii spaces and hyphens",
+			isbn:        " 978-0-09 958898-6 ",
+			expRes:      "9780099588986",
+			expStrictOK: true,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "en dash, em dash and non-breaking space",
+			isbn:   "978–0—09 958898–6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "full-width digits and hyphens",
+			isbn:   "９７８－００９９５８８９８６",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "lower case x",
+			isbn:   "0-439-42089-x",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "full-width lower case x",
+			isbn:   "043942089ｘ",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN: prefix",
+			isbn:   "ISBN: 978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN-13 prefix",
+			isbn:   "isbn-13 9780099588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN-10 prefix",
+			isbn:   "ISBN-10: 0099588986",
+			expRes: "0099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN 13: prefix with a space",
+			isbn:   "ISBN 13: 978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN–13: prefix with an en dash",
+			isbn:   "ISBN–13: 978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "ISBN13: prefix",
+			isbn:   "ISBN13:9780099588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 starting with 13 is not taken for a label",
+			isbn:   "ISBN 1391234561",
+			expRes: "1391234561",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "urn:isbn: prefix",
+			isbn:   "URN:ISBN:978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 prefixed with 978 without recomputing the check digit",
+			isbn:   "978-0-439-42089-X",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 with a numeric check digit prefixed with 978 without recomputing it",
+			isbn:   "978-0306406152",
+			expRes: "0306406152",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 prefixed with 978 and hyphenated without recomputing the check digit",
+			isbn:   "978-1101973390",
+			expRes: "1101973390",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "barcode scanner output with ean-5 add-on",
+			isbn:   "978009958898651299",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "barcode scanner output with ean-2 add-on",
+			isbn:   "9780099588986 12",
+			expRes: "9780099588986",
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseISBN(v.isbn)
+		assert.Nil(t, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+		assert.Equal(t, v.isbn, actRes.Original())
+
+		_, actErr = ParseISBNStrict(v.isbn)
+		assert.Equal(t, v.expStrictOK, actErr == nil)
+	}
+}
+
+func TestNormalizeError(t *testing.T) {
+	valErr := &ValidationError{}
+	_, err := ParseISBN("ISBN: 978-0-09-958898-7")
+	assert.ErrorAs(t, err, &valErr)
+	assert.Equal(t, &ValidationError{ISBN: "ISBN: 978-0-09-958898-7", Reason: ReasonCheckDigit, Length: 13, Position: 13, Char: '7', Expected: '6'}, valErr)
+}
@@ -0,0 +1,77 @@
+package goisbn
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a GoISBN created with New
+type Option func(*GoISBN) error
+
+// WithHTTPClient sets the HTTP client used by the built-in providers, an
+// *http.Client with the timeout set by WithTimeout by default
+func WithHTTPClient(client HTTPClient) Option {
+	return func(gi *GoISBN) error {
+		if client == nil {
+			return errInvalidHTTPClient
+		}
+		gi.client = client
+		return nil
+	}
+}
+
+// WithAPIKey sets the API key of the provider, ie: ProviderGoodreads or
+// ProviderIsbndb. Providers requiring an API key are not queried without one
+func WithAPIKey(provider, key string) Option {
+	return func(gi *GoISBN) error {
+		switch provider {
+		case ProviderGoodreads:
+			gi.goodreadAPIKey = key
+		case ProviderIsbndb:
+			gi.isbndbAPIKey = key
+		default:
+			return fmt.Errorf("%w: %s", errNoAPIKey, provider)
+		}
+		return nil
+	}
+}
+
+// WithTimeout sets the maximum duration of a lookup, 3 seconds by default
+func WithTimeout(timeout time.Duration) Option {
+	return func(gi *GoISBN) error {
+		if timeout <= 0 {
+			return errInvalidTimeout
+		}
+		gi.timeout = timeout
+		return nil
+	}
+}
+
+// WithProviders sets the providers queried, among the built-in providers and
+// the ones registered with Register. Defaults to DEFAULT_PROVIDERS
+func WithProviders(providers ...string) Option {
+	return func(gi *GoISBN) error {
+		gi.providers = providers
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the API of a built-in provider, eg: a
+// caching proxy, a mirror or a httptest server, in place of its public
+// endpoint. The URL must be an absolute http or https URL, it may have a path
+// prefix, eg: https://proxy.internal/openlibrary
+func WithBaseURL(provider, baseURL string) Option {
+	return func(gi *GoISBN) error {
+		if _, ok := gi.baseURLs[provider]; !ok {
+			return fmt.Errorf("%w: %s", errNoBaseURL, provider)
+		}
+		u, err := url.Parse(baseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("%w for %s: %q", errInvalidBaseURL, provider, baseURL)
+		}
+		gi.baseURLs[provider] = strings.TrimSuffix(baseURL, "/")
+		return nil
+	}
+}
@@ -0,0 +1,269 @@
+package goisbn
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	type testCase struct {
+		name           string
+		desc           string
+		opts           []Option
+		expProviders   []string
+		expGoodreadKey string
+		expIsbndbKey   string
+		expTimeout     time.Duration
+		expErr         error
+	}
+	testCases := []testCase{
+		{
+			name:         "Happy Case",
+			desc:         "no options, default providers not requiring an api key",
+			expProviders: []string{ProviderGoogle, ProviderOpenLibrary},
+			expTimeout:   timeout,
+		},
+		{
+			name: "Happy Case",
+			desc: "api keys set, all default providers",
+			opts: []Option{
+				WithAPIKey(ProviderGoodreads, "tenant goodreads key"),
+				WithAPIKey(ProviderIsbndb, "tenant isbndb key"),
+			},
+			expProviders:   DEFAULT_PROVIDERS,
+			expGoodreadKey: "tenant goodreads key",
+			expIsbndbKey:   "tenant isbndb key",
+			expTimeout:     timeout,
+		},
+		{
+			name: "Happy Case",
+			desc: "selected providers, goodreads removed without api key",
+			opts: []Option{
+				WithProviders(ProviderGoogle, ProviderGoodreads),
+				WithTimeout(10 * time.Second),
+			},
+			expProviders: []string{ProviderGoogle},
+			expTimeout:   10 * time.Second,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "unknown provider",
+			opts:   []Option{WithProviders(ProviderGoogle, "unknown")},
+			expErr: errUnknownProvider,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "api key of a provider that does not take one",
+			opts:   []Option{WithAPIKey(ProviderOpenLibrary, "key")},
+			expErr: errNoAPIKey,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid timeout",
+			opts:   []Option{WithTimeout(0)},
+			expErr: errInvalidTimeout,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "nil http client",
+			opts:   []Option{WithHTTPClient(nil)},
+			expErr: errInvalidHTTPClient,
+		},
+	}
+
+	defer unsetEnv()()
+	for _, v := range testCases {
+		gi, actErr := New(v.opts...)
+		assert.ErrorIs(t, actErr, v.expErr, v.desc)
+		if v.expErr != nil {
+			assert.Nil(t, gi, v.desc)
+			continue
+		}
+		assert.ElementsMatch(t, v.expProviders, gi.providers, v.desc)
+		assert.Equal(t, v.expGoodreadKey, gi.goodreadAPIKey, v.desc)
+		assert.Equal(t, v.expIsbndbKey, gi.isbndbAPIKey, v.desc)
+		assert.Equal(t, v.expTimeout, gi.timeout, v.desc)
+		assert.Equal(t, &http.Client{Timeout: v.expTimeout}, gi.client, v.desc)
+	}
+}
+
+func TestNewTenants(t *testing.T) {
+	keys := []string{}
+	client := &MockClient{
+		MockDo: func(req *http.Request) (*http.Response, error) {
+			keys = append(keys, req.Header.Get(authorizationHeaderKey))
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"book": {"title_long": "Me Before You", "isbn13": "9780099588986"}}`))),
+			}, nil
+		},
+	}
+	for _, key := range []string{"tenant a key", "tenant b key"} {
+		gi, err := New(WithProviders(ProviderIsbndb), WithAPIKey(ProviderIsbndb, key), WithHTTPClient(client))
+		assert.Nil(t, err)
+		book, err := gi.Get("9780099588986")
+		assert.Nil(t, err)
+		assert.Equal(t, "Me Before You", book.Title)
+	}
+	assert.Equal(t, []string{"tenant a key", "tenant b key"}, keys)
+}
+
+func TestWithBaseURL(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		provider string
+		baseURL  string
+		expRes   string
+		expErr   error
+	}
+	testCases := []testCase{
+		{
+			name:     "Happy Case",
+			desc:     "caching proxy with a path prefix, trailing slash trimmed",
+			provider: ProviderGoogle,
+			baseURL:  "https://proxy.internal/google/",
+			expRes:   "https://proxy.internal/google",
+		},
+		{
+			name:     "Happy Case",
+			desc:     "self hosted mirror over http with a port",
+			provider: ProviderOpenLibrary,
+			baseURL:  "http://openlibrary.local:8080",
+			expRes:   "http://openlibrary.local:8080",
+		},
+		{
+			name:     "Sad Case",
+			desc:     "provider without a base url",
+			provider: "catalog",
+			baseURL:  "https://catalog.internal",
+			expErr:   errNoBaseURL,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "relative url",
+			provider: ProviderIsbndb,
+			baseURL:  "/isbndb",
+			expErr:   errInvalidBaseURL,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "unsupported scheme",
+			provider: ProviderGoodreads,
+			baseURL:  "ftp://www.goodreads.com",
+			expErr:   errInvalidBaseURL,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "url with a query",
+			provider: ProviderGoogle,
+			baseURL:  "https://www.googleapis.com?key=abc",
+			expErr:   errInvalidBaseURL,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "unparsable url",
+			provider: ProviderGoogle,
+			baseURL:  "https://%zz",
+			expErr:   errInvalidBaseURL,
+		},
+	}
+
+	for _, v := range testCases {
+		gi, actErr := New(WithBaseURL(v.provider, v.baseURL))
+		assert.ErrorIs(t, actErr, v.expErr, v.desc)
+		if v.expErr != nil {
+			assert.Nil(t, gi, v.desc)
+			continue
+		}
+		assert.Equal(t, v.expRes, gi.baseURLs[v.provider], v.desc)
+	}
+}
+
+func TestWithBaseURLServer(t *testing.T) {
+	paths := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/google/books/v1/volumes":
+			w.Write([]byte(`{"totalItems": 1, "items": [{"volumeInfo": {"title": "Me Before You", "industryIdentifiers": [{"type": "ISBN_13", "identifier": "9780099588986"}]}}]}`))
+		case "/openlibrary/api/books":
+			w.Write([]byte(`{"ISBN:9780099588986": {"title": "Me Before You"}}`))
+		case "/goodreads/search/index.xml":
+			w.Write([]byte(`<GoodreadsResponse><search><results><work><best_book><title>Me Before You</title></best_book></work></results></search></GoodreadsResponse>`))
+		case "/isbndb/book/9780099588986":
+			w.Write([]byte(`{"book": {"title_long": "Me Before You", "isbn13": "9780099588986"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	gi, err := New(
+		WithAPIKey(ProviderGoodreads, "key"),
+		WithAPIKey(ProviderIsbndb, "key"),
+		WithBaseURL(ProviderGoogle, srv.URL+"/google"),
+		WithBaseURL(ProviderOpenLibrary, srv.URL+"/openlibrary/"),
+		WithBaseURL(ProviderGoodreads, srv.URL+"/goodreads"),
+		WithBaseURL(ProviderIsbndb, srv.URL+"/isbndb"),
+	)
+	assert.Nil(t, err)
+	isbn := mustParseISBN("9780099588986")
+	lookups := map[string]func(context.Context, ISBN) (*Book, error){
+		ProviderGoogle:      gi.lookupGoogle,
+		ProviderOpenLibrary: gi.lookupOpenLibrary,
+		ProviderGoodreads:   gi.lookupGoodreads,
+		ProviderIsbndb:      gi.lookupISBNDB,
+	}
+	for provider, lookup := range lookups {
+		book, err := lookup(context.Background(), isbn)
+		assert.Nil(t, err, provider)
+		if assert.NotNil(t, book, provider) {
+			assert.Equal(t, "Me Before You", book.Title, provider)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		"/google/books/v1/volumes",
+		"/openlibrary/api/books",
+		"/goodreads/search/index.xml",
+		"/isbndb/book/9780099588986",
+	}, paths)
+}
+
+func TestWithTimeoutIdentifiers(t *testing.T) {
+	// the client has no timeout of its own, only the one set with WithTimeout
+	// bounds the lookups
+	client := &MockClient{
+		MockDo: func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	}
+	gi, err := New(WithProviders(ProviderGoogle), WithHTTPClient(client), WithTimeout(50*time.Millisecond))
+	assert.Nil(t, err)
+
+	lookups := map[string]func() (*Book, error){
+		"issn": func() (*Book, error) { return gi.GetISSN("0317-8471") },
+		"ismn": func() (*Book, error) { return gi.GetISMN("9790260000438") },
+	}
+	for desc, lookup := range lookups {
+		done := make(chan error, 1)
+		go func() {
+			_, err := lookup()
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, ErrProviderUnavailable, desc)
+		case <-time.After(time.Second):
+			assert.Fail(t, "lookup not bounded by the timeout", desc)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package goisbn
+
+import (
+	"context"
+	"sync"
+)
+
+// Provider looks up the details of books from a source, eg: a book API or an
+// in-house catalog. Providers registered with Register take part in Get like
+// the built-in ones
+type Provider interface {
+	// Name identifies the provider in the providers list given to
+	// WithProviders or NewGoISBN, eg: google
+	Name() string
+	// Lookup returns the details of the book with the ISBN, or an error if the
+	// book is not found or the source cannot be queried, wrapping ErrNotFound
+	// or ErrProviderUnavailable respectively. It must return when the context
+	// is done
+	Lookup(ctx context.Context, isbn ISBN) (*Book, error)
+	// Capabilities describes what the provider supports
+	Capabilities() Capabilities
+}
+
+// Capabilities describes what a Provider supports
+type Capabilities struct {
+	// ISBN10 reports whether the provider can look books up by ISBN 10
+	ISBN10 bool
+	// ISBN13 reports whether the provider can look books up by ISBN 13
+	ISBN13 bool
+}
+
+// supports reports whether the provider can look the ISBN up in its form
+func (c Capabilities) supports(isbn ISBN) bool {
+	return (isbn.IsISBN10() && c.ISBN10) || (isbn.IsISBN13() && c.ISBN13)
+}
+
+// registry contains the providers registered with Register, by name
+var registry = struct {
+	sync.RWMutex
+	providers map[string]Provider
+}{providers: map[string]Provider{}}
+
+// Register makes the provider available by its name to the GoISBN created
+// afterwards with New or NewGoISBN. It returns an error if the provider is nil,
+// its name is empty, is the name of a built-in provider or is already
+// registered
+func Register(p Provider) error {
+	if p == nil {
+		return errInvalidProvider
+	}
+	name := p.Name()
+	if name == "" {
+		return errInvalidProvider
+	}
+	for _, v := range DEFAULT_PROVIDERS {
+		if v == name {
+			return errProviderRegistered
+		}
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.providers[name]; ok {
+		return errProviderRegistered
+	}
+	registry.providers[name] = p
+	return nil
+}
+
+// registeredProviders returns a copy of the providers registered with Register
+func registeredProviders() map[string]Provider {
+	registry.RLock()
+	defer registry.RUnlock()
+	res := make(map[string]Provider, len(registry.providers))
+	for k, v := range registry.providers {
+		res[k] = v
+	}
+	return res
+}
+
+// builtinProvider is a Provider shipped with the package, looking books up
+// through the HTTP client of its GoISBN
+type builtinProvider struct {
+	name   string
+	lookup func(ctx context.Context, isbn ISBN) (*Book, error)
+}
+
+func (p *builtinProvider) Name() string {
+	return p.name
+}
+
+func (p *builtinProvider) Lookup(ctx context.Context, isbn ISBN) (*Book, error) {
+	return p.lookup(ctx, isbn)
+}
+
+// Capabilities of the built-in providers, which all index both forms of ISBN
+func (p *builtinProvider) Capabilities() Capabilities {
+	return Capabilities{ISBN10: true, ISBN13: true}
+}
@@ -0,0 +1,140 @@
+package goisbn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubProvider is a Provider returning the same book for every ISBN it
+// supports, recording the ISBNs it is asked for
+type stubProvider struct {
+	name    string
+	caps    Capabilities
+	book    *Book
+	err     error
+	lookups []string
+}
+
+func (p *stubProvider) Name() string {
+	return p.name
+}
+
+func (p *stubProvider) Lookup(ctx context.Context, isbn ISBN) (*Book, error) {
+	p.lookups = append(p.lookups, isbn.String())
+	return p.book, p.err
+}
+
+func (p *stubProvider) Capabilities() Capabilities {
+	return p.caps
+}
+
+// unregister removes a provider registered by a test
+func unregister(name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.providers, name)
+}
+
+func TestRegister(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		provider Provider
+		expErr   error
+	}
+	testCases := []testCase{
+		{
+			name:     "Happy Case",
+			desc:     "new provider",
+			provider: &stubProvider{name: "catalog"},
+		},
+		{
+			name:     "Sad Case",
+			desc:     "provider already registered",
+			provider: &stubProvider{name: "catalog"},
+			expErr:   errProviderRegistered,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "name of a built-in provider",
+			provider: &stubProvider{name: ProviderGoogle},
+			expErr:   errProviderRegistered,
+		},
+		{
+			name:     "Sad Case",
+			desc:     "empty name",
+			provider: &stubProvider{},
+			expErr:   errInvalidProvider,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "nil provider",
+			expErr: errInvalidProvider,
+		},
+	}
+
+	defer unregister("catalog")
+	for _, v := range testCases {
+		actErr := Register(v.provider)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+	}
+}
+
+func TestGetRegisteredProvider(t *testing.T) {
+	type testCase struct {
+		name       string
+		desc       string
+		isbn       string
+		caps       Capabilities
+		book       *Book
+		err        error
+		expRes     *Book
+		expErr     error
+		expLookups []string
+	}
+	book := &Book{Title: "Me Before You", Source: "catalog"}
+	testCases := []testCase{
+		{
+			name:       "Happy Case",
+			desc:       "registered provider found the book",
+			isbn:       "9780099588986",
+			caps:       Capabilities{ISBN10: true, ISBN13: true},
+			book:       book,
+			expRes:     book,
+			expLookups: []string{"9780099588986"},
+		},
+		{
+			name:       "Happy Case",
+			desc:       "provider only supporting isbn 13 is asked for the isbn 13 form",
+			isbn:       "0099588986",
+			caps:       Capabilities{ISBN13: true},
+			book:       book,
+			expRes:     book,
+			expLookups: []string{"9780099588986"},
+		},
+		{
+			name:       "Sad Case",
+			desc:       "registered provider returns an error for both forms",
+			isbn:       "9780099588986",
+			caps:       Capabilities{ISBN10: true, ISBN13: true},
+			err:        ErrNotFound,
+			expErr:     ErrNotFound,
+			expLookups: []string{"9780099588986", "0099588986"},
+		},
+	}
+
+	for _, v := range testCases {
+		p := &stubProvider{name: "catalog", caps: v.caps, book: v.book, err: v.err}
+		assert.Nil(t, Register(p), v.desc)
+		gi := NewGoISBN([]string{"catalog", "unknown"})
+		unregister("catalog")
+
+		actRes, actErr := gi.Get(v.isbn)
+		assert.Equal(t, []string{"catalog"}, gi.providers, v.desc)
+		assert.Equal(t, v.expRes, actRes, v.desc)
+		assert.ErrorIs(t, actErr, v.expErr, v.desc)
+		assert.Equal(t, v.expLookups, p.lookups, v.desc)
+	}
+}
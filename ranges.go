@@ -0,0 +1,252 @@
+package goisbn
+
+import (
+	// embed is required for the range message bundled with the package
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// rangeMessageXML is the RangeMessage.xml export of the International ISBN
+// Agency, see https://www.isbn-international.org/range_file_generation
+//
+//go:embed rangemessage.xml
+var rangeMessageXML []byte
+
+// ranges holds the range table used for lookups, the bundled one until
+// LoadRangeMessage replaces it
+var ranges = newRangeStore(mustParseRangeMessage(rangeMessageXML))
+
+// RangeInfo identifies the range message in use
+type RangeInfo struct {
+	// Source is the issuer of the range message, eg: International ISBN Agency
+	Source string
+	// SerialNumber uniquely identifies the range message
+	SerialNumber string
+	// Date is the date the range message was generated, as written in the
+	// message, eg: Fri, 1 Oct 2021 10:21:41 BST
+	Date string
+}
+
+// LoadRangeMessage replaces the range data used for hyphenation and range
+// lookups with the RangeMessage.xml read from r, eg: a fresh export of
+// https://www.isbn-international.org/range_file_generation. The range data in
+// use is left unchanged if the message cannot be parsed. It is safe to call
+// concurrently with lookups, which use either the old or the new range data
+func LoadRangeMessage(r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("error reading range message: %w", err)
+	}
+	rt, err := parseRangeMessage(data)
+	if err != nil {
+		return err
+	}
+	ranges.store(rt)
+	return nil
+}
+
+// RangeMessageInfo returns the source, serial number and date of the range
+// message in use
+func RangeMessageInfo() RangeInfo {
+	rt := ranges.load()
+	return RangeInfo{
+		Source:       rt.source,
+		SerialNumber: rt.serialNumber,
+		Date:         rt.date,
+	}
+}
+
+// rangeStore holds the range table in use. Range tables are never modified once
+// parsed so they are swapped as a whole
+type rangeStore struct {
+	v atomic.Value
+}
+
+func newRangeStore(rt *rangeTable) *rangeStore {
+	s := &rangeStore{}
+	s.store(rt)
+	return s
+}
+
+func (s *rangeStore) load() *rangeTable {
+	return s.v.Load().(*rangeTable)
+}
+
+func (s *rangeStore) store(rt *rangeTable) {
+	s.v.Store(rt)
+}
+
+type rangeMessage struct {
+	Source       string       `xml:"MessageSource"`
+	SerialNumber string       `xml:"MessageSerialNumber"`
+	Date         string       `xml:"MessageDate"`
+	Prefixes     []rangeGroup `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups       []rangeGroup `xml:"RegistrationGroups>Group"`
+}
+
+type rangeGroup struct {
+	Prefix string `xml:"Prefix"`
+	Agency string `xml:"Agency"`
+	Rules  []struct {
+		Range  string `xml:"Range"`
+		Length int    `xml:"Length"`
+	} `xml:"Rules>Rule"`
+}
+
+type rangeRule struct {
+	lo     int
+	hi     int
+	length int
+}
+
+// registrationGroup is either an EAN.UCC prefix, eg: 978, or a registration
+// group, eg: 978-0, along with the rules splitting the digits that follow it
+type registrationGroup struct {
+	prefix string
+	agency string
+	rules  []rangeRule
+}
+
+// rangeTable is the parsed form of a range message
+type rangeTable struct {
+	source       string
+	serialNumber string
+	date         string
+	prefixes     map[string]*registrationGroup
+	groups       map[string]*registrationGroup
+}
+
+// isbnParts contains the elements of an ISBN 13
+type isbnParts struct {
+	prefix      string
+	group       string
+	registrant  string
+	publication string
+	checkDigit  string
+}
+
+func mustParseRangeMessage(data []byte) *rangeTable {
+	rt, err := parseRangeMessage(data)
+	if err != nil {
+		panic(err)
+	}
+	return rt
+}
+
+func parseRangeMessage(data []byte) (*rangeTable, error) {
+	msg := &rangeMessage{}
+	if err := xml.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("error decoding range message: %w", err)
+	}
+	rt := &rangeTable{
+		source:       msg.Source,
+		serialNumber: msg.SerialNumber,
+		date:         msg.Date,
+		prefixes:     map[string]*registrationGroup{},
+		groups:       map[string]*registrationGroup{},
+	}
+	for _, v := range msg.Prefixes {
+		g, err := newRegistrationGroup(v)
+		if err != nil {
+			return nil, err
+		}
+		rt.prefixes[g.prefix] = g
+	}
+	for _, v := range msg.Groups {
+		g, err := newRegistrationGroup(v)
+		if err != nil {
+			return nil, err
+		}
+		rt.groups[g.prefix] = g
+	}
+	if len(rt.prefixes) == 0 || len(rt.groups) == 0 {
+		return nil, errEmptyRangeMessage
+	}
+	return rt, nil
+}
+
+func newRegistrationGroup(rg rangeGroup) (*registrationGroup, error) {
+	g := &registrationGroup{
+		prefix: rg.Prefix,
+		agency: rg.Agency,
+	}
+	for _, v := range rg.Rules {
+		bounds := strings.Split(v.Range, "-")
+		if len(bounds) != 2 || len(bounds[0]) != 7 || len(bounds[1]) != 7 {
+			return nil, fmt.Errorf("invalid range %q for %s", v.Range, rg.Prefix)
+		}
+		lo, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q for %s", v.Range, rg.Prefix)
+		}
+		hi, err := strconv.Atoi(bounds[1])
+		if err != nil || lo > hi {
+			return nil, fmt.Errorf("invalid range %q for %s", v.Range, rg.Prefix)
+		}
+		// the elements split by the rules are at most 7 digits long
+		if v.Length < 0 || v.Length > 7 {
+			return nil, fmt.Errorf("invalid length %d of range %q for %s", v.Length, v.Range, rg.Prefix)
+		}
+		g.rules = append(g.rules, rangeRule{lo: lo, hi: hi, length: v.Length})
+	}
+	return g, nil
+}
+
+// length returns the length of the element starting at the first digit of the
+// 7 digits provided, 0 if the range is not assigned
+func (g *registrationGroup) length(digits string) int {
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0
+	}
+	for _, v := range g.rules {
+		if n >= v.lo && n <= v.hi {
+			return v.length
+		}
+	}
+	return 0
+}
+
+// registrationGroup returns the registration group of a valid ISBN 13
+func (rt *rangeTable) registrationGroup(isbn13 string) (*registrationGroup, error) {
+	prefix, ok := rt.prefixes[isbn13[:3]]
+	if !ok {
+		return nil, ErrUnassignedRange
+	}
+	l := prefix.length(isbn13[3:10])
+	if l == 0 {
+		return nil, ErrUnassignedRange
+	}
+	group, ok := rt.groups[prefix.prefix+"-"+isbn13[3:3+l]]
+	if !ok {
+		return nil, errGroupNotFound
+	}
+	return group, nil
+}
+
+// split breaks a valid ISBN 13 down into its elements
+func (rt *rangeTable) split(isbn13 string) (isbnParts, error) {
+	group, err := rt.registrationGroup(isbn13)
+	if err != nil {
+		return isbnParts{}, err
+	}
+	l := len(group.prefix) - len("978-")
+	payload := isbn13[3+l : 12]
+	r := group.length((payload + "0000000")[:7])
+	if r == 0 || r >= len(payload) {
+		return isbnParts{}, ErrUnassignedRange
+	}
+	return isbnParts{
+		prefix:      isbn13[:3],
+		group:       isbn13[3 : 3+l],
+		registrant:  payload[:r],
+		publication: payload[r:],
+		checkDigit:  isbn13[12:],
+	}, nil
+}
@@ -0,0 +1,168 @@
+package goisbn
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeMessage(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		data   string
+		expErr bool
+	}
+	testCases := []testCase{
+		{
+			name: "Happy Case",
+			desc: "bundled range message",
+			data: string(rangeMessageXML),
+		},
+		{
+			name:   "Sad Case",
+			desc:   "malformed xml",
+			data:   "<ISBNRangeMessage>",
+			expErr: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "no ranges",
+			data:   "<ISBNRangeMessage></ISBNRangeMessage>",
+			expErr: true,
+		},
+		{
+			name: "Sad Case",
+			desc: "invalid range",
+			data: `<ISBNRangeMessage>
+				<EAN.UCCPrefixes>
+					<EAN.UCC>
+						<Prefix>978</Prefix>
+						<Rules><Rule><Range>0-5999999</Range><Length>1</Length></Rule></Rules>
+					</EAN.UCC>
+				</EAN.UCCPrefixes>
+			</ISBNRangeMessage>`,
+			expErr: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "range bounds in reverse order",
+			data:   strings.Replace(string(rangeMessageXML), "<Range>0000000-5999999</Range>", "<Range>5999999-0000000</Range>", 1),
+			expErr: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "length longer than the 7 digits a rule covers",
+			data:   strings.Replace(string(rangeMessageXML), "<Length>1</Length>", "<Length>11</Length>", 1),
+			expErr: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "negative length",
+			data:   strings.Replace(string(rangeMessageXML), "<Length>1</Length>", "<Length>-1</Length>", 1),
+			expErr: true,
+		},
+	}
+
+	for _, v := range testCases {
+		rt, actErr := parseRangeMessage([]byte(v.data))
+		assert.Equal(t, v.expErr, actErr != nil)
+		assert.Equal(t, v.expErr, rt == nil)
+	}
+}
+
+func TestLoadRangeMessage(t *testing.T) {
+	// the updated message assigns the 978-87-3 registrant range of Denmark
+	updated := strings.Replace(string(rangeMessageXML), `<Range>3000000-3999999</Range>
+					<Length>0</Length>`, `<Range>3000000-3999999</Range>
+					<Length>3</Length>`, 1)
+	updated = strings.Replace(updated, "8f0b3e6a-4b8f-4b6e-9c2e-7d1f5a9c3b21", "0c9e6f4d-7a51-4d0e-8b8e-3f2a6c1d9e57", 1)
+	updated = strings.Replace(updated, "Fri, 1 Oct 2021 10:21:41 BST", "Mon, 3 Jan 2022 09:12:05 GMT", 1)
+
+	bundled := RangeInfo{
+		Source:       "International ISBN Agency",
+		SerialNumber: "8f0b3e6a-4b8f-4b6e-9c2e-7d1f5a9c3b21",
+		Date:         "Fri, 1 Oct 2021 10:21:41 BST",
+	}
+	type testCase struct {
+		name         string
+		desc         string
+		data         string
+		readErr      error
+		expInfo      RangeInfo
+		expHyphenate string
+		expErr       bool
+	}
+	testCases := []testCase{
+		{
+			name: "Happy Case",
+			desc: "updated range message assigns a registrant range",
+			data: updated,
+			expInfo: RangeInfo{
+				Source:       "International ISBN Agency",
+				SerialNumber: "0c9e6f4d-7a51-4d0e-8b8e-3f2a6c1d9e57",
+				Date:         "Mon, 3 Jan 2022 09:12:05 GMT",
+			},
+			expHyphenate: "978-87-300-0000-2",
+		},
+		{
+			name:    "Sad Case",
+			desc:    "malformed xml leaves the range data unchanged",
+			data:    "<ISBNRangeMessage>",
+			expInfo: bundled,
+			expErr:  true,
+		},
+		{
+			name:    "Sad Case",
+			desc:    "out of bounds length leaves the range data unchanged",
+			data:    strings.Replace(updated, "<Length>1</Length>", "<Length>11</Length>", 1),
+			expInfo: bundled,
+			expErr:  true,
+		},
+		{
+			name:    "Sad Case",
+			desc:    "read error leaves the range data unchanged",
+			readErr: errors.New("connection reset"),
+			expInfo: bundled,
+			expErr:  true,
+		},
+	}
+
+	for _, v := range testCases {
+		r := iotest.ErrReader(v.readErr)
+		if v.readErr == nil {
+			r = strings.NewReader(v.data)
+		}
+		actErr := LoadRangeMessage(r)
+		assert.Equal(t, v.expErr, actErr != nil, v.desc)
+		assert.Equal(t, v.expInfo, RangeMessageInfo(), v.desc)
+		actRes, _ := Hyphenate("9788730000002")
+		assert.Equal(t, v.expHyphenate, actRes, v.desc)
+		assert.Nil(t, LoadRangeMessage(bytes.NewReader(rangeMessageXML)))
+	}
+}
+
+func TestLoadRangeMessageConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for k := 0; k < 4; k++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 20; n++ {
+				actRes, actErr := Hyphenate("9780099588986")
+				assert.Nil(t, actErr)
+				assert.Equal(t, "978-0-09-958898-6", actRes)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, LoadRangeMessage(bytes.NewReader(rangeMessageXML)))
+		}()
+	}
+	wg.Wait()
+}
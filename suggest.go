@@ -0,0 +1,72 @@
+package goisbn
+
+import "sort"
+
+// Suggest lists every valid ISBN that differs from the isbn provided by a
+// single substituted character or a single transposition of two adjacent
+// characters, the errors the ISBN check digit is designed to detect. ISBNs
+// reported as assigned by IsAssigned are ranked first, followed by
+// transpositions, then substitutions in order of position.
+// Returns nil if the isbn is already valid or is not 10 or 13 characters long
+// once spaces and hyphens are removed
+func Suggest(isbn string) []ISBN {
+	if _, err := ParseISBN(isbn); err == nil {
+		return nil
+	}
+	runes := []rune(normalize(isbn))
+	if len(runes) != 10 && len(runes) != 13 {
+		return nil
+	}
+
+	type suggestion struct {
+		isbn     ISBN
+		assigned bool
+		rank     int
+	}
+	seen := map[string]bool{}
+	suggestions := []suggestion{}
+	add := func(candidate []rune, rank int) {
+		s := string(candidate)
+		if seen[s] {
+			return
+		}
+		seen[s] = true
+		i, err := ParseISBNStrict(s)
+		if err != nil {
+			return
+		}
+		suggestions = append(suggestions, suggestion{isbn: i, assigned: i.IsAssigned(), rank: rank})
+	}
+
+	candidate := make([]rune, len(runes))
+	for k := 0; k < len(runes)-1; k++ {
+		if runes[k] == runes[k+1] {
+			continue
+		}
+		copy(candidate, runes)
+		candidate[k], candidate[k+1] = candidate[k+1], candidate[k]
+		add(candidate, k)
+	}
+	for k := range runes {
+		copy(candidate, runes)
+		for _, v := range "0123456789X" {
+			if v == runes[k] {
+				continue
+			}
+			candidate[k] = v
+			add(candidate, len(runes)+k)
+		}
+	}
+
+	sort.SliceStable(suggestions, func(a, b int) bool {
+		if suggestions[a].assigned != suggestions[b].assigned {
+			return suggestions[a].assigned
+		}
+		return suggestions[a].rank < suggestions[b].rank
+	})
+	res := make([]ISBN, len(suggestions))
+	for k, v := range suggestions {
+		res[k] = v.isbn
+	}
+	return res
+}
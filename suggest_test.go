@@ -0,0 +1,93 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggest(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		isbn     string
+		expFirst string
+		expLast  string
+		expLen   int
+		contains string
+	}
+	testCases := []testCase{
+		{
+			name:     "Happy Case",
+			desc:     "adjacent digits transposed",
+			isbn:     "9780099588968",
+			expFirst: "9780099588986",
+			expLast:  "9780099588962",
+			expLen:   11,
+		},
+		{
+			name:     "Happy Case",
+			desc:     "single digit substituted",
+			isbn:     "978-0-09-955898-6",
+			expFirst: "9781099558986",
+			expLast:  "9780099558989",
+			expLen:   10,
+			contains: "9780099588986",
+		},
+		{
+			name:     "Happy Case",
+			desc:     "isbn 10, check digit X suggested",
+			isbn:     "0439420890",
+			expFirst: "0349420890",
+			expLast:  "043942089X",
+			expLen:   10,
+		},
+		{
+			name:     "Happy Case",
+			desc:     "suggestion in an unassigned range ranked last",
+			isbn:     "9788730000003",
+			expFirst: "9781730000003",
+			expLast:  "9788730000002",
+			expLen:   10,
+		},
+		{
+			name:     "Happy Case",
+			desc:     "suggestion in a group missing from the range data is not ranked as unassigned",
+			isbn:     "9786310000009",
+			expFirst: "9789310000009",
+			expLast:  "9786310000008",
+			expLen:   10,
+		},
+		{
+			name: "Sad Case",
+			desc: "valid isbn",
+			isbn: "9780099588986",
+		},
+		{
+			name: "Sad Case",
+			desc: "invalid length",
+			isbn: "978009958898",
+		},
+	}
+
+	for _, v := range testCases {
+		actRes := Suggest(v.isbn)
+		assert.Equal(t, v.expLen, len(actRes))
+		if v.expLen == 0 {
+			continue
+		}
+		assert.Equal(t, v.expFirst, actRes[0].String())
+		assert.Equal(t, v.expLast, actRes[len(actRes)-1].String())
+		for k, s := range actRes {
+			_, err := validate(s.String())
+			assert.Nil(t, err)
+			// assigned suggestions are ranked first, as reported by IsAssigned
+			if k > 0 && !actRes[k-1].IsAssigned() {
+				assert.False(t, s.IsAssigned(), v.desc)
+			}
+		}
+		if v.contains != "" {
+			assert.Contains(t, actRes, mustParseISBN(v.contains))
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package goisbn
+
+import "strings"
+
+const (
+	isbnADOIPrefix = "10."
+	urnISBNPrefix  = "urn:isbn:"
+)
+
+// isbnAResolverPrefixes are stripped from the start of an ISBN-A before it is
+// parsed
+var isbnAResolverPrefixes = []string{"https://doi.org/", "http://doi.org/", "https://dx.doi.org/", "http://dx.doi.org/", "doi:"}
+
+// ISBNA returns the actionable ISBN-A form of the ISBN, a DOI built from its
+// ISBN 13 form, eg: 10.978.009/9588986 for 978-0-09-958898-6
+func (i ISBN) ISBNA() (string, error) {
+	isbn13, err := i.ToISBN13()
+	if err != nil {
+		return "", err
+	}
+	p, err := isbn13.parts()
+	if err != nil {
+		return "", err
+	}
+	return isbnADOIPrefix + p.prefix + "." + p.group + p.registrant + "/" + p.publication + p.checkDigit, nil
+}
+
+// URN returns the ISBN as a RFC 3187 urn:isbn: URI, with the hyphenated ISBN
+// 13 as its namespace specific string, eg: urn:isbn:978-0-09-958898-6
+func (i ISBN) URN() (string, error) {
+	isbn13, err := i.ToISBN13()
+	if err != nil {
+		return "", err
+	}
+	h, err := isbn13.Hyphenated()
+	if err != nil {
+		return "", err
+	}
+	return urnISBNPrefix + h, nil
+}
+
+// ParseISBNA parses an ISBN-A, eg: 10.978.009/9588986, optionally prefixed
+// with doi: or a doi.org resolver URL. The boundary between the registrant and
+// the publication elements must match the ranges allocated by the
+// International ISBN Agency
+func ParseISBNA(isbnA string) (ISBN, error) {
+	s := strings.TrimSpace(isbnA)
+	for _, v := range isbnAResolverPrefixes {
+		if len(s) >= len(v) && strings.EqualFold(s[:len(v)], v) {
+			s = s[len(v):]
+			break
+		}
+	}
+	if !strings.HasPrefix(s, isbnADOIPrefix) {
+		return ISBN{}, errInvalidISBNA
+	}
+	s = s[len(isbnADOIPrefix):]
+	slash := strings.IndexByte(s, '/')
+	dot := strings.IndexByte(s, '.')
+	if dot != 3 || slash < dot {
+		return ISBN{}, errInvalidISBNA
+	}
+	i, err := ParseISBNStrict(s[:dot] + s[dot+1:slash] + s[slash+1:])
+	if err != nil || !i.IsISBN13() {
+		return ISBN{}, errInvalidISBNA
+	}
+	p, err := i.parts()
+	if err != nil {
+		return ISBN{}, err
+	}
+	if s[dot+1:slash] != p.group+p.registrant {
+		return ISBN{}, errInvalidISBNA
+	}
+	i.original = isbnA
+	return i, nil
+}
+
+// ParseURN parses a RFC 3187 urn:isbn: URI, eg: urn:isbn:978-0-09-958898-6
+func ParseURN(urn string) (ISBN, error) {
+	s := strings.TrimSpace(urn)
+	if len(s) < len(urnISBNPrefix) || !strings.EqualFold(s[:len(urnISBNPrefix)], urnISBNPrefix) {
+		return ISBN{}, errInvalidURN
+	}
+	i, err := ParseISBNStrict(s[len(urnISBNPrefix):])
+	if err != nil {
+		return ISBN{}, err
+	}
+	i.original = urn
+	return i, nil
+}
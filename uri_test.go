@@ -0,0 +1,168 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISBNAAndURN(t *testing.T) {
+	type testCase struct {
+		name     string
+		desc     string
+		isbn     string
+		expISBNA string
+		expURN   string
+		expErr   error
+	}
+	testCases := []testCase{
+		{
+			name:     "Happy Case",
+			desc:     "isbn 13",
+			isbn:     "9780099588986",
+			expISBNA: "10.978.009/9588986",
+			expURN:   "urn:isbn:978-0-09-958898-6",
+		},
+		{
+			name:     "Happy Case",
+			desc:     "isbn 10 formatted from its isbn 13 form",
+			isbn:     "0099588986",
+			expISBNA: "10.978.009/9588986",
+			expURN:   "urn:isbn:978-0-09-958898-6",
+		},
+		{
+			name:     "Happy Case",
+			desc:     "979 prefix, 2 digits registration group",
+			isbn:     "9791032305690",
+			expISBNA: "10.979.10323/05690",
+			expURN:   "urn:isbn:979-10-323-0569-0",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registrant range not assigned",
+			isbn:   "9788730000002",
+			expErr: ErrUnassignedRange,
+		},
+	}
+
+	for _, v := range testCases {
+		i := mustParseISBN(v.isbn)
+		actISBNA, actErr := i.ISBNA()
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expISBNA, actISBNA)
+		actURN, actErr := i.URN()
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expURN, actURN)
+	}
+}
+
+func TestParseISBNA(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		isbnA  string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn-a",
+			isbnA:  "10.978.009/9588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "doi.org resolver URL",
+			isbnA:  "https://doi.org/10.978.009/9588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "doi: prefix",
+			isbnA:  "doi:10.979.10323/05690",
+			expRes: "9791032305690",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "wrong registrant boundary",
+			isbnA:  "10.978.0099/588986",
+			expErr: errInvalidISBNA,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "not a doi",
+			isbnA:  "11.978.009/9588986",
+			expErr: errInvalidISBNA,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "missing prefix",
+			isbnA:  "10.009/9588986",
+			expErr: errInvalidISBNA,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid check digit",
+			isbnA:  "10.978.009/9588987",
+			expErr: errInvalidISBNA,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "registrant range not assigned",
+			isbnA:  "10.978.87300/00002",
+			expErr: ErrUnassignedRange,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseISBNA(v.isbnA)
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes.String())
+		if v.expErr == nil {
+			assert.Equal(t, v.isbnA, actRes.Original())
+		}
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		urn    string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "hyphenated isbn 13",
+			urn:    "urn:isbn:978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "upper case isbn 10",
+			urn:    "URN:ISBN:0-395-36341-1",
+			expRes: "0395363411",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "missing urn:isbn: prefix",
+			urn:    "isbn:9780099588986",
+			expErr: errInvalidURN,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid isbn",
+			urn:    "urn:isbn:9780099588987",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ParseURN(v.urn)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expRes, actRes.String())
+	}
+}
@@ -0,0 +1,149 @@
+package goisbn
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// urlExtractor extracts the ISBN candidates from the URLs of a site
+type urlExtractor struct {
+	// match reports whether the host belongs to the site
+	match func(host string) bool
+	// extract returns the ISBN candidates found in the URL, most likely first
+	extract func(u *url.URL) []string
+}
+
+// urlExtractors are tried in order, the first one matching the host of a URL
+// is used. URLs of other sites, eg: publisher pages, are searched for ISBNs in
+// their path and query
+var urlExtractors = []urlExtractor{
+	{match: hostHasLabel("amazon"), extract: amazonCandidates},
+	{match: hostHasLabel("goodreads"), extract: goodreadsCandidates},
+	{match: hostHasLabel("openlibrary"), extract: openLibraryCandidates},
+	{match: isGoogleBooksHost, extract: googleBooksCandidates},
+}
+
+// ISBNFromURL returns the ISBN found in a bookstore or catalog URL, eg: an
+// Amazon /dp/ URL, whose ASIN is the ISBN 10 of print books, a Goodreads
+// /book/isbn/ URL, an Open Library /isbn/ URL, a Google Books URL with a
+// vid=ISBN... parameter, or a publisher page with the ISBN in its path or
+// query. The scheme may be omitted, eg: amazon.com/dp/0099588986
+func ISBNFromURL(rawURL string) (ISBN, error) {
+	s := strings.TrimSpace(rawURL)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return ISBN{}, errInvalidURL
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, v := range urlExtractors {
+		if !v.match(host) {
+			continue
+		}
+		for _, c := range v.extract(u) {
+			if i, err := ParseISBN(c); err == nil {
+				i.original = rawURL
+				return i, nil
+			}
+		}
+		break
+	}
+	matches := FindISBNs(genericCandidates(u))
+	if len(matches) == 0 {
+		return ISBN{}, errISBNNotInURL
+	}
+	i := matches[0].ISBN
+	i.original = rawURL
+	return i, nil
+}
+
+// hostHasLabel returns a matcher of the hosts with the label, eg: amazon
+// matches www.amazon.co.uk
+func hostHasLabel(label string) func(host string) bool {
+	return func(host string) bool {
+		for _, v := range strings.Split(host, ".") {
+			if v == label {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func isGoogleBooksHost(host string) bool {
+	return strings.HasPrefix(host, "books.google.") || strings.HasPrefix(host, "play.google.")
+}
+
+// pathSegments returns the unescaped segments of the path of the URL
+func pathSegments(u *url.URL) []string {
+	return strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+}
+
+// amazonCandidates returns the ASIN of /dp/, /gp/product/, /gp/aw/d/ and
+// /exec/obidos/ASIN/ URLs
+func amazonCandidates(u *url.URL) []string {
+	var res []string
+	segments := pathSegments(u)
+	for k := 0; k < len(segments)-1; k++ {
+		switch segments[k] {
+		case "dp", "product", "d", "ASIN":
+			res = append(res, segments[k+1])
+		}
+	}
+	return res
+}
+
+// goodreadsCandidates returns the ISBN of /book/isbn/ URLs and of searches
+func goodreadsCandidates(u *url.URL) []string {
+	var res []string
+	segments := pathSegments(u)
+	for k := 0; k < len(segments)-2; k++ {
+		if segments[k] == "book" && segments[k+1] == "isbn" {
+			res = append(res, segments[k+2])
+		}
+	}
+	q := u.Query()
+	return append(res, q.Get("isbn"), q.Get("q"))
+}
+
+// openLibraryCandidates returns the ISBN of /isbn/ URLs, with or without an
+// extension, eg: /isbn/9780099588986.json
+func openLibraryCandidates(u *url.URL) []string {
+	var res []string
+	segments := pathSegments(u)
+	for k := 0; k < len(segments)-1; k++ {
+		if segments[k] == "isbn" {
+			s := segments[k+1]
+			res = append(res, strings.TrimSuffix(s, path.Ext(s)))
+		}
+	}
+	return res
+}
+
+// googleBooksCandidates returns the ISBN of the vid and isbn parameters, eg:
+// vid=ISBN9780099588986, and of isbn: searches, eg: q=isbn:9780099588986
+func googleBooksCandidates(u *url.URL) []string {
+	q := u.Query()
+	return []string{q.Get("vid"), q.Get("isbn"), q.Get("q")}
+}
+
+// genericCandidates returns the text of the URL searched for ISBNs when no
+// extractor finds one, ie: the query values followed by the path segments,
+// one per line. Query values are sorted by key
+func genericCandidates(u *url.URL) string {
+	q := u.Query()
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var lines []string
+	for _, k := range keys {
+		lines = append(lines, q[k]...)
+	}
+	return strings.Join(append(lines, pathSegments(u)...), "\n")
+}
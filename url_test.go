@@ -0,0 +1,106 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISBNFromURL(t *testing.T) {
+	type testCase struct {
+		name   string
+		desc   string
+		url    string
+		expRes string
+		expErr error
+	}
+	testCases := []testCase{
+		{
+			name:   "Happy Case",
+			desc:   "amazon /dp/ url with title and ref",
+			url:    "https://www.amazon.com/Me-Before-You-Jojo-Moyes/dp/0099588986/ref=sr_1_1?keywords=me+before+you",
+			expRes: "0099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "amazon /gp/product/ url on a regional site",
+			url:    "https://www.amazon.co.uk/gp/product/043942089X",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "amazon url without scheme",
+			url:    "amazon.de/dp/316148410X",
+			expRes: "316148410X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "goodreads /book/isbn/ url",
+			url:    "https://www.goodreads.com/book/isbn/9781101973394",
+			expRes: "9781101973394",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "goodreads search url",
+			url:    "https://www.goodreads.com/search?q=978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "open library /isbn/ url with extension",
+			url:    "https://openlibrary.org/isbn/9780099588986.json",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "google books url with vid parameter",
+			url:    "https://books.google.com/books?vid=ISBN9780099588986&redir_esc=y",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "google books isbn: search",
+			url:    "https://books.google.co.uk/books?q=isbn:1101973390",
+			expRes: "1101973390",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "publisher page with the isbn in its path",
+			url:    "https://www.penguin.co.uk/books/111/me-before-you/9780099588986.html",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "publisher page with the isbn in its query",
+			url:    "https://www.example.com/catalog/product?id=42&ean=9781101973394",
+			expRes: "9781101973394",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "amazon kindle asin is not an isbn",
+			url:    "https://www.amazon.com/dp/B00AEBETU2",
+			expErr: errISBNNotInURL,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "goodreads /book/show/ url has no isbn",
+			url:    "https://www.goodreads.com/book/show/15507958-me-before-you",
+			expErr: errISBNNotInURL,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid url",
+			url:    "https://%zz",
+			expErr: errInvalidURL,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := ISBNFromURL(v.url)
+		assert.Equal(t, v.expErr, actErr, v.desc)
+		assert.Equal(t, v.expRes, actRes.String(), v.desc)
+		if v.expErr == nil {
+			assert.Equal(t, v.url, actRes.Original(), v.desc)
+		}
+	}
+}
@@ -1,7 +1,8 @@
 package goisbn
 
 import (
-	"strconv"
+	"errors"
+	"strings"
 )
 
 func validate10(isbn10 string) bool {
@@ -22,36 +23,238 @@ func validate13(isbn13 string) bool {
 
 func sum10(isbn string) int {
 	s := 0
-	w := 10
-	for k, v := range isbn {
-		if k == 9 && v == 88 {
+	for k := 0; k < len(isbn); k++ {
+		v := isbn[k]
+		switch {
+		case k == 9 && v == 'X':
 			s += 10
-		} else {
-			n, err := strconv.Atoi(string(v))
-			if err != nil {
-				return -1
-			}
-			s += n * w
+		case v >= '0' && v <= '9':
+			s += int(v-'0') * (10 - k)
+		default:
+			return -1
 		}
-		w--
 	}
 	return s
 }
 
 func sum13(isbn string) int {
 	s := 0
-	w := 1
-	for _, v := range isbn {
-		n, err := strconv.Atoi(string(v))
-		if err != nil {
+	for k := 0; k < len(isbn); k++ {
+		v := isbn[k]
+		if v < '0' || v > '9' {
 			return -1
 		}
-		s += n * w
-		if w == 1 {
-			w = 3
+		if k%2 == 0 {
+			s += int(v - '0')
 		} else {
-			w = 1
+			s += int(v-'0') * 3
 		}
 	}
 	return s
 }
+
+func checkDigit10(stem string) byte {
+	c := (11 - sum10(stem)%11) % 11
+	if c == 10 {
+		return 'X'
+	}
+	return byte('0' + c)
+}
+
+func checkDigit13(stem string) byte {
+	return byte('0' + (10-sum13(stem)%10)%10)
+}
+
+// validate removes the spaces and hyphens from the isbn and checks that the
+// result is in a valid ISBN 10 or ISBN 13 format, returning a
+// *ValidationError otherwise
+func validate(isbn string) (string, error) {
+	if ValidateString(isbn) {
+		return stripSeparators(isbn), nil
+	}
+	canonical := stripSeparators(isbn)
+	runes := []rune(canonical)
+	for k, v := range runes {
+		if (v < '0' || v > '9') && v != 'X' {
+			return "", &ValidationError{ISBN: isbn, Reason: ReasonInvalidCharacter, Length: len(runes), Position: k + 1, Char: v}
+		}
+	}
+	l := len(canonical)
+	if l != 10 && l != 13 {
+		return "", &ValidationError{ISBN: isbn, Reason: ReasonLength, Length: l}
+	}
+	if k := strings.IndexByte(canonical, 'X'); k != -1 && (l != 10 || k != 9) {
+		return "", &ValidationError{ISBN: isbn, Reason: ReasonMisplacedX, Length: l, Position: k + 1, Char: 'X'}
+	}
+	if l == 13 {
+		if f := family(canonical); f != FamilyISBN {
+			prefix := canonical[:3]
+			if f == FamilyISMN {
+				prefix = "979-0"
+			}
+			return "", &ValidationError{ISBN: isbn, Reason: ReasonInvalidPrefix, Length: l, Prefix: prefix, Family: f}
+		}
+	}
+	if (l == 10 && !validate10(canonical)) || (l == 13 && !validate13(canonical)) {
+		expected := checkDigit10(canonical[:9])
+		if l == 13 {
+			expected = checkDigit13(canonical[:12])
+		}
+		return "", &ValidationError{ISBN: isbn, Reason: ReasonCheckDigit, Length: l, Position: l, Char: rune(canonical[l-1]), Expected: rune(expected)}
+	}
+	return canonical, nil
+}
+
+// ValidateBytes checks if the isbn is in a valid ISBN 10 or ISBN 13 format,
+// only ignoring ASCII spaces and hyphens like ParseISBNStrict. It does not
+// allocate and stops at the first byte that cannot be part of a valid isbn,
+// which suits streaming parsers and bulk imports
+func ValidateBytes(isbn []byte) bool {
+	var c isbnChecker
+	for _, v := range isbn {
+		if !c.add(v) {
+			return false
+		}
+	}
+	return c.valid()
+}
+
+// ValidateString is ValidateBytes for a string
+func ValidateString(isbn string) bool {
+	var c isbnChecker
+	for k := 0; k < len(isbn); k++ {
+		if !c.add(isbn[k]) {
+			return false
+		}
+	}
+	return c.valid()
+}
+
+// isbnChecker validates an isbn fed one byte at a time, keeping the ISBN 10
+// and ISBN 13 weighted sums of the digits seen so far
+type isbnChecker struct {
+	n      int
+	x      bool
+	sum10  int
+	sum13  int
+	prefix int
+}
+
+// add feeds the next byte of the isbn to the checker and reports whether the
+// isbn can still be valid
+func (c *isbnChecker) add(b byte) bool {
+	switch {
+	case b == ' ' || b == '-':
+		return true
+	case c.x:
+		// X can only be the check digit of an ISBN 10
+		return false
+	case b == 'X':
+		if c.n != 9 {
+			return false
+		}
+		c.x = true
+		c.sum10 += 10
+		c.n++
+		return true
+	case b < '0' || b > '9' || c.n == 13:
+		return false
+	}
+	d := int(b - '0')
+	if c.n < 10 {
+		c.sum10 += d * (10 - c.n)
+	}
+	if c.n%2 == 0 {
+		c.sum13 += d
+	} else {
+		c.sum13 += d * 3
+	}
+	if c.n < 4 {
+		c.prefix = c.prefix*10 + d
+	}
+	c.n++
+	// an isbn longer than 10 digits must be an ISBN 13 with a Bookland prefix,
+	// 979-0 being reserved for ISMN
+	return c.n != 11 || c.prefix/10 == 978 || (c.prefix/10 == 979 && c.prefix != 9790)
+}
+
+// valid reports whether the bytes fed to the checker form a valid isbn
+func (c *isbnChecker) valid() bool {
+	switch c.n {
+	case 10:
+		return c.sum10%11 == 0
+	case 13:
+		return c.sum13%10 == 0
+	}
+	return false
+}
+
+// CheckDigit10 computes the ISBN 10 check digit of the 9 digits stem provided,
+// spaces and hyphens are ignored
+func CheckDigit10(stem string) (rune, error) {
+	stem, err := cleanStem(stem, 9)
+	if err != nil {
+		return 0, err
+	}
+	return rune(checkDigit10(stem)), nil
+}
+
+// CheckDigit13 computes the ISBN 13 check digit of the 12 digits stem provided,
+// spaces and hyphens are ignored
+func CheckDigit13(stem string) (rune, error) {
+	stem, err := cleanStem(stem, 12)
+	if err != nil {
+		return 0, err
+	}
+	return rune(checkDigit13(stem)), nil
+}
+
+// Complete appends the check digit to the 9 or 12 digits stem provided and
+// returns the resulting ISBN 10 or ISBN 13
+func Complete(stem string) (ISBN, error) {
+	canonical := stripSeparators(stem)
+	var c rune
+	var err error
+	switch len(canonical) {
+	case 9:
+		c, err = CheckDigit10(canonical)
+	case 12:
+		c, err = CheckDigit13(canonical)
+	default:
+		return ISBN{}, errInvalidStem
+	}
+	if err != nil {
+		return ISBN{}, err
+	}
+	i, err := ParseISBN(canonical + string(c))
+	if err != nil {
+		return ISBN{}, err
+	}
+	i.original = stem
+	return i, nil
+}
+
+// Repair returns the isbn with its check digit recomputed from its other
+// digits. Any other error than a check digit mismatch is returned as is
+func Repair(isbn string) (ISBN, error) {
+	i, err := ParseISBN(isbn)
+	valErr := &ValidationError{}
+	if !errors.As(err, &valErr) || valErr.Reason != ReasonCheckDigit {
+		return i, err
+	}
+	canonical := normalize(isbn)
+	return ISBN{original: isbn, canonical: canonical[:valErr.Length-1] + string(valErr.Expected)}, nil
+}
+
+func cleanStem(stem string, length int) (string, error) {
+	stem = stripSeparators(stem)
+	if len(stem) != length {
+		return "", errInvalidStem
+	}
+	for _, v := range stem {
+		if v < '0' || v > '9' {
+			return "", errInvalidStem
+		}
+	}
+	return stem, nil
+}
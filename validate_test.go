@@ -79,3 +79,378 @@ func TestValidate13(t *testing.T) {
 		assert.Equal(t, v.expRes, actRes)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	type TestCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr *ValidationError
+	}
+	testCases := []TestCase{
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn 13 with hyphens",
+			isbn:   "978-0-09-958898-6",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn 10, last digit X",
+			isbn:   "043942089X",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			isbn:   "00995889862",
+			expErr: &ValidationError{ISBN: "00995889862", Reason: ReasonLength, Length: 11},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid character, position ignores hyphens",
+			isbn:   "978-0-09-95C898-6",
+			expErr: &ValidationError{ISBN: "978-0-09-95C898-6", Reason: ReasonInvalidCharacter, Length: 13, Position: 9, Char: 'C'},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid non ascii character",
+			isbn:   "97800995é8986",
+			expErr: &ValidationError{ISBN: "97800995é8986", Reason: ReasonInvalidCharacter, Length: 13, Position: 9, Char: 'é'},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "X in isbn 10, not as check digit",
+			isbn:   "00995X8986",
+			expErr: &ValidationError{ISBN: "00995X8986", Reason: ReasonMisplacedX, Length: 10, Position: 6, Char: 'X'},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "X as check digit of isbn 13",
+			isbn:   "978009958898X",
+			expErr: &ValidationError{ISBN: "978009958898X", Reason: ReasonMisplacedX, Length: 13, Position: 13, Char: 'X'},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid bookland prefix",
+			isbn:   "9770099588986",
+			expErr: &ValidationError{ISBN: "9770099588986", Reason: ReasonInvalidPrefix, Length: 13, Prefix: "977", Family: FamilyISSN},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "ismn prefix",
+			isbn:   "9790000000001",
+			expErr: &ValidationError{ISBN: "9790000000001", Reason: ReasonInvalidPrefix, Length: 13, Prefix: "979-0", Family: FamilyISMN},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "non book ean",
+			isbn:   "4006381333931",
+			expErr: &ValidationError{ISBN: "4006381333931", Reason: ReasonInvalidPrefix, Length: 13, Prefix: "400", Family: FamilyEAN},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn 13 check digit mismatch",
+			isbn:   "9780099588987",
+			expErr: &ValidationError{ISBN: "9780099588987", Reason: ReasonCheckDigit, Length: 13, Position: 13, Char: '7', Expected: '6'},
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn 10 check digit mismatch",
+			isbn:   "0-09-958898-X",
+			expErr: &ValidationError{ISBN: "0-09-958898-X", Reason: ReasonCheckDigit, Length: 10, Position: 10, Char: 'X', Expected: '6'},
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := validate(v.isbn)
+		assert.Equal(t, v.expRes, actRes)
+		if v.expErr == nil {
+			assert.Nil(t, actErr)
+			continue
+		}
+		valErr := &ValidationError{}
+		assert.ErrorAs(t, actErr, &valErr)
+		assert.Equal(t, v.expErr, valErr)
+		assert.ErrorIs(t, actErr, ErrInvalidISBN)
+		assert.NotEmpty(t, actErr.Error())
+	}
+}
+
+func TestValidateBytes(t *testing.T) {
+	type TestCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes bool
+	}
+	testCases := []TestCase{
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn 13 with hyphens",
+			isbn:   "978-0-09-958898-6",
+			expRes: true,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn 10 with spaces, last digit X",
+			isbn:   "0 439 42089 X",
+			expRes: true,
+		},
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn 13 with the 979 prefix",
+			isbn:   "9791032305690",
+			expRes: true,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "empty",
+			isbn:   "",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			isbn:   "97800995889",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "too long",
+			isbn:   "97800995889861",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid character",
+			isbn:   "978-0-09-95C898-6",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "lower case x is only accepted by normalization",
+			isbn:   "043942089x",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "X not as check digit",
+			isbn:   "00995X8986",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "X followed by digits",
+			isbn:   "043942089X123",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "ismn prefix",
+			isbn:   "9790000000001",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "non book ean",
+			isbn:   "4006381333931",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn 13 check digit mismatch",
+			isbn:   "9780099588987",
+			expRes: false,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "isbn 10 check digit mismatch",
+			isbn:   "009958898X",
+			expRes: false,
+		},
+	}
+
+	for _, v := range testCases {
+		assert.Equal(t, v.expRes, ValidateBytes([]byte(v.isbn)), v.desc)
+		assert.Equal(t, v.expRes, ValidateString(v.isbn), v.desc)
+		_, err := ParseISBNStrict(v.isbn)
+		assert.Equal(t, v.expRes, err == nil, v.desc)
+		allocs := testing.AllocsPerRun(10, func() {
+			ValidateString(v.isbn)
+		})
+		assert.Zero(t, allocs, v.desc)
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	type TestCase struct {
+		name   string
+		desc   string
+		stem   string
+		expRes rune
+		expErr error
+	}
+	testCases := []TestCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 stem",
+			stem:   "009958898",
+			expRes: '6',
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 stem, check digit X",
+			stem:   "0-439-42089",
+			expRes: 'X',
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13 stem",
+			stem:   "978-0-09-958898",
+			expRes: '6',
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			stem:   "0099588",
+			expErr: errInvalidStem,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid character",
+			stem:   "00995889X",
+			expErr: errInvalidStem,
+		},
+	}
+
+	for _, v := range testCases {
+		var actRes rune
+		var actErr error
+		if len(v.stem) < 12 {
+			actRes, actErr = CheckDigit10(v.stem)
+		} else {
+			actRes, actErr = CheckDigit13(v.stem)
+		}
+		assert.Equal(t, v.expErr, actErr)
+		assert.Equal(t, v.expRes, actRes)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	type TestCase struct {
+		name   string
+		desc   string
+		stem   string
+		expRes string
+		expErr error
+	}
+	testCases := []TestCase{
+		{
+			name:   "Happy Case",
+			desc:   "isbn 10 stem",
+			stem:   "009958898",
+			expRes: "0099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "isbn 13 stem",
+			stem:   "978-0-09-958898",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			stem:   "0099588",
+			expErr: errInvalidStem,
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid bookland prefix",
+			stem:   "977009958898",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := Complete(v.stem)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expRes, actRes.String())
+		if v.expErr == nil {
+			assert.Equal(t, v.stem, actRes.Original())
+		}
+	}
+}
+
+func TestRepair(t *testing.T) {
+	type TestCase struct {
+		name   string
+		desc   string
+		isbn   string
+		expRes string
+		expErr error
+	}
+	testCases := []TestCase{
+		{
+			name:   "Happy Case",
+			desc:   "wrong isbn 13 check digit",
+			isbn:   "978-0-09-958898-7",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "wrong isbn 10 check digit",
+			isbn:   "0439420890",
+			expRes: "043942089X",
+		},
+		{
+			name:   "Happy Case",
+			desc:   "valid isbn returned as is",
+			isbn:   "9780099588986",
+			expRes: "9780099588986",
+		},
+		{
+			name:   "Sad Case",
+			desc:   "invalid length",
+			isbn:   "978009958898",
+			expErr: ErrInvalidISBN,
+		},
+	}
+
+	for _, v := range testCases {
+		actRes, actErr := Repair(v.isbn)
+		assert.ErrorIs(t, actErr, v.expErr)
+		assert.Equal(t, v.expRes, actRes.String())
+	}
+}
+
+func BenchmarkValidateBytes(b *testing.B) {
+	isbn := []byte("978-0-09-958898-6")
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		ValidateBytes(isbn)
+	}
+}
+
+func BenchmarkValidateString(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		ValidateString("978-0-09-958898-6")
+	}
+}
+
+func BenchmarkValidateISBN(b *testing.B) {
+	gi := NewGoISBN(DEFAULT_PROVIDERS)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		gi.ValidateISBN("978-0-09-958898-6")
+	}
+}
+
+func BenchmarkParseISBN(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseISBN("978-0-09-958898-6")
+	}
+}
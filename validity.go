@@ -0,0 +1,61 @@
+package goisbn
+
+// Validity is how valid an isbn is, each level passing the checks of the
+// levels below it
+type Validity int
+
+const (
+	// ValidityMalformed means the isbn is not an ISBN 10 or ISBN 13, eg: it has
+	// the wrong length, an invalid character or a prefix other than Bookland
+	ValidityMalformed Validity = iota + 1
+	// ValidityBadChecksum means the isbn is well formed but its check digit
+	// does not match the one computed from its other digits
+	ValidityBadChecksum
+	// ValidityUnassigned means the isbn has a valid check digit but falls in a
+	// registration group or registrant range the International ISBN Agency
+	// has not assigned, so no book can have it
+	ValidityUnassigned
+	// ValidityValid means the isbn has a valid check digit and falls in an
+	// assigned range
+	ValidityValid
+)
+
+func (v Validity) String() string {
+	switch v {
+	case ValidityMalformed:
+		return "malformed"
+	case ValidityBadChecksum:
+		return "bad checksum"
+	case ValidityUnassigned:
+		return "unassigned"
+	case ValidityValid:
+		return "valid"
+	}
+	return "unknown"
+}
+
+// CheckValidity returns the validity of the isbn, consulting the range data
+// on top of the checks of ParseISBN. Registration groups that are assigned but
+// missing from the range data are assumed to be assigned
+func CheckValidity(isbn string) Validity {
+	i, err := ParseISBN(isbn)
+	if err != nil {
+		if valErr, ok := err.(*ValidationError); ok && valErr.Reason == ReasonCheckDigit {
+			return ValidityBadChecksum
+		}
+		return ValidityMalformed
+	}
+	if !i.IsAssigned() {
+		return ValidityUnassigned
+	}
+	return ValidityValid
+}
+
+// IsAssigned reports whether the ISBN falls in a registration group and
+// registrant range assigned by the International ISBN Agency. Registration
+// groups that are assigned but missing from the range data are assumed to be
+// assigned
+func (i ISBN) IsAssigned() bool {
+	_, err := i.parts()
+	return err != ErrUnassignedRange
+}
@@ -0,0 +1,81 @@
+package goisbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckValidity(t *testing.T) {
+	type testCase struct {
+		name      string
+		desc      string
+		isbn      string
+		expRes    Validity
+		expString string
+	}
+	testCases := []testCase{
+		{
+			name:      "Happy Case",
+			desc:      "isbn 13 in an assigned range",
+			isbn:      "978-0-09-958898-6",
+			expRes:    ValidityValid,
+			expString: "valid",
+		},
+		{
+			name:      "Happy Case",
+			desc:      "isbn 10 in an assigned range",
+			isbn:      "043942089X",
+			expRes:    ValidityValid,
+			expString: "valid",
+		},
+		{
+			name:      "Happy Case",
+			desc:      "registration group missing from the range data is assumed assigned",
+			isbn:      "9786310000008",
+			expRes:    ValidityValid,
+			expString: "valid",
+		},
+		{
+			name:      "Sad Case",
+			desc:      "unassigned registrant range",
+			isbn:      "9788730000002",
+			expRes:    ValidityUnassigned,
+			expString: "unassigned",
+		},
+		{
+			name:      "Sad Case",
+			desc:      "unassigned registration group",
+			isbn:      "9791300000005",
+			expRes:    ValidityUnassigned,
+			expString: "unassigned",
+		},
+		{
+			name:      "Sad Case",
+			desc:      "check digit mismatch",
+			isbn:      "9780099588987",
+			expRes:    ValidityBadChecksum,
+			expString: "bad checksum",
+		},
+		{
+			name:      "Sad Case",
+			desc:      "invalid length",
+			isbn:      "978009958898",
+			expRes:    ValidityMalformed,
+			expString: "malformed",
+		},
+		{
+			name:      "Sad Case",
+			desc:      "ismn is not an isbn",
+			isbn:      "9790000000001",
+			expRes:    ValidityMalformed,
+			expString: "malformed",
+		},
+	}
+
+	for _, v := range testCases {
+		actRes := CheckValidity(v.isbn)
+		assert.Equal(t, v.expRes, actRes, v.desc)
+		assert.Equal(t, v.expString, actRes.String(), v.desc)
+	}
+}